@@ -0,0 +1,17 @@
+package main
+
+// dictionary maps a character to the glyphs commonly swapped in for it in
+// a typosquat — leetspeak digits and lookalike symbols — and feeds the
+// "substitution" permute.Strategy.
+var dictionary = map[rune][]string{
+	'a': {"4", "@"},
+	'b': {"8"},
+	'e': {"3"},
+	'g': {"9"},
+	'i': {"1", "!"},
+	'l': {"1"},
+	'o': {"0"},
+	's': {"5", "$"},
+	't': {"7"},
+	'z': {"2"},
+}
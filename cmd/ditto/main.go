@@ -4,38 +4,55 @@ import (
 	"flag"
 	"fmt"
 	pb "github.com/cheggaaa/pb/v3"
-	"github.com/domainr/whois"
 	"github.com/evilsocket/islazy/async"
-	"github.com/evilsocket/islazy/tui"
-	tld "github.com/jpillora/go-tld"
-	"github.com/likexian/whois-parser-go"
+	"github.com/krishpranav/ditto/fingerprint"
+	"github.com/krishpranav/ditto/monitor"
+	"github.com/krishpranav/ditto/permute"
+	"github.com/krishpranav/ditto/psl"
+	"github.com/krishpranav/ditto/report"
+	"github.com/krishpranav/ditto/resolve"
+	"github.com/krishpranav/ditto/whoisq"
 	"golang.org/x/net/idna"
 	"net"
+	"net/url"
 	"os"
-	"encoding/csv"
 	"strings"
+	"time"
 )
 
-type Entry struct {
-	Domain    string
-	Ascii     string
-	Available bool
-	Whois     *whoisparser.WhoisInfo
-	Addresses []string
-	Names     []string
-}
+// Entry is an alias for report.Entry so the rest of main doesn't need to
+// know output formatting lives in its own package.
+type Entry = report.Entry
 
 var (
-	url         = "https://www.ice.gov"
-	limit       = 0
-	entries     = make([]*Entry, 0)
-	queue       = async.NewQueue(0, processEntry)
-	progress    = (* pb.ProgressBar)(nil)
-	availOnly   = false
-	regOnly     = false
-	liveOnly    = false
-	whoisInfo   = false
-	csvFileName = ""
+	domainArg      = "https://www.ice.gov"
+	limit          = 0
+	entries        = make([]*Entry, 0)
+	queue          = async.NewQueue(0, processEntry)
+	progress       = (* pb.ProgressBar)(nil)
+	availOnly      = false
+	regOnly        = false
+	liveOnly       = false
+	whoisInfo      = false
+	csvFileName    = ""
+	jsonFileName   = ""
+	jsonl          = false
+	strategies     = "substitution"
+	nameservers    = ""
+	resolverDiff   = false
+	resolver       *resolve.Resolver
+	httpProbe      = false
+	tlsProbe       = false
+	similarFavicon = ""
+	jsonlReporter  *report.JSONLReporter
+	tldExpand      = false
+	watch          = ""
+	configPath     = ""
+	whoisCache     = "ditto-whois-cache.db"
+	whoisCacheTTL  = "24h"
+	whoisRate      = whoisq.DefaultRate
+	whoisBurst     = whoisq.DefaultBurst
+	whoisClient    *whoisq.Client
 )
 
 func die(format string, a ...interface{}) {
@@ -44,62 +61,96 @@ func die(format string, a ...interface{}) {
 }
 
 func init() {
-	flag.StringVar(&url, "domain", url, "Domain name or url.")
+	flag.StringVar(&domainArg, "domain", domainArg, "Domain name or url.")
 	flag.IntVar(&limit, "limit", limit, "Limit the number of permutations.")
 	flag.BoolVar(&availOnly, "available", availOnly, "Only display available domain names.")
 	flag.BoolVar(&regOnly, "registered", regOnly, "Only display registered domain names.")
 	flag.BoolVar(&liveOnly, "live", liveOnly, "Only display registered domain names that also resolve to an IP.")
 	flag.BoolVar(&whoisInfo, "whois", whoisInfo, "Show whois information.")
 	flag.StringVar(&csvFileName, "csv", csvFileName, "If set ditto will save results to this CSV file.")
+	flag.StringVar(&jsonFileName, "json", jsonFileName, "If set ditto will save results to this file as a single JSON array.")
+	flag.BoolVar(&jsonl, "jsonl", jsonl, "Stream results to stdout as newline-delimited JSON as each entry completes.")
+	flag.StringVar(&strategies, "strategies", strategies, fmt.Sprintf("Comma separated list of permutation strategies to run (%s).", strings.Join(permute.Names(), ", ")))
+	flag.StringVar(&nameservers, "nameservers", nameservers, "Comma separated list of extra nameservers (host:port) to query in addition to the built-in public resolvers.")
+	flag.BoolVar(&resolverDiff, "resolver-diff", resolverDiff, "Only display entries whose resolvers disagree on at least one DNS record.")
+	flag.BoolVar(&httpProbe, "http-probe", httpProbe, "Fingerprint the HTTP(S) server of resolving candidates (status, headers, title, favicon hash).")
+	flag.BoolVar(&tlsProbe, "tls-probe", tlsProbe, "Capture the TLS certificate of resolving candidates.")
+	flag.StringVar(&similarFavicon, "similar-favicon", similarFavicon, "Only display entries whose favicon hash matches this value (implies --http-probe).")
+	flag.BoolVar(&tldExpand, "tld-expand", tldExpand, "Also generate variants of the label against a curated shortlist of other TLDs/ccTLDs (shorthand for adding the \"tld\" strategy).")
+	flag.StringVar(&watch, "watch", watch, "Re-run the full pipeline on this interval (e.g. 1h, 30m) and alert on changes, rather than exiting after one pass.")
+	flag.StringVar(&configPath, "config", configPath, "Path to the YAML config file used by --watch (sinks, on-disk store path).")
+	flag.StringVar(&whoisCache, "whois-cache", whoisCache, "Path to the on-disk WHOIS/RDAP cache. Empty disables caching.")
+	flag.StringVar(&whoisCacheTTL, "whois-cache-ttl", whoisCacheTTL, "How long a cached WHOIS/RDAP lookup is trusted before it's re-queried.")
+	flag.Float64Var(&whoisRate, "whois-rate", whoisRate, "Max WHOIS queries/sec to send to any single WHOIS host.")
+	flag.IntVar(&whoisBurst, "whois-burst", whoisBurst, "Max burst of WHOIS queries allowed against a single host in a single instant.")
 }
 
-func genEntries(parsed *tld.URL) {
-	for i, c := range parsed.Domain {
-		if substitutes, found := dictionary[c]; found {
-			for _, sub := range substitutes {
-				entries = append(entries, &Entry{
-					Domain: fmt.Sprintf("%s%s%s.%s", parsed.Domain[:i], sub, parsed.Domain[i+1:], parsed.TLD),
-				})
-				if limit > 0 && len(entries) == limit {
-					return
-				}
-			}
-		}
+func genEntries(label, suffix string) {
+	names := strings.Split(strategies, ",")
+	if tldExpand && !contains(names, "tld") {
+		names = append(names, "tld")
 	}
-}
 
-func isAvailable(domain string) (bool, *whoisparser.WhoisInfo) {
-	req, err := whois.NewRequest(domain)
+	engine, err := permute.NewEngine(names, permute.Options{Dictionary: dictionary})
 	if err != nil {
-		return true, nil
+		die("%v\n", err)
 	}
 
-	resp, err := whois.DefaultClient.Fetch(req)
-	if err != nil {
-		return true, nil
+	for _, candidate := range engine.Generate(label, suffix) {
+		entries = append(entries, &Entry{
+			Domain: candidate.Domain,
+		})
+		if limit > 0 && len(entries) == limit {
+			return
+		}
 	}
+}
 
-	parsed, err := whoisparser.Parse(string(resp.Body))
-	if err != nil {
-		return true, nil
+func contains(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if s == needle {
+			return true
+		}
 	}
+	return false
+}
 
-	return false, &parsed
+// addresses collects the distinct A/AAAA answers seen across every
+// resolver in matrix, used as the entry's canonical "is this live"
+// address list regardless of which resolver happened to answer.
+func addresses(matrix resolve.Matrix) []string {
+	uniq := make(map[string]bool)
+	for _, records := range matrix {
+		for _, recordType := range []string{"A", "AAAA"} {
+			for _, addr := range records[recordType] {
+				uniq[addr] = true
+			}
+		}
+	}
+
+	addrs := make([]string, 0, len(uniq))
+	for addr := range uniq {
+		addrs = append(addrs, addr)
+	}
+	return addrs
 }
 
 func processEntry(arg async.Job) {
 	defer progress.Increment()
 
 	entry := arg.(*Entry)
-	entry.Available, entry.Whois = isAvailable(entry.Domain)
 	entry.Ascii, _ = idna.ToASCII(entry.Domain)
-	// some whois might only be accepting ascii encoded domain names
-	if entry.Available {
-		entry.Available, entry.Whois = isAvailable(entry.Ascii)
+
+	entry.Status, entry.Whois = whoisClient.Lookup(entry.Domain)
+	// some WHOIS/RDAP backends only accept ascii encoded domain names
+	if entry.Status == whoisq.Unknown {
+		entry.Status, entry.Whois = whoisClient.Lookup(entry.Ascii)
 	}
 
-	if !entry.Available {
-		entry.Addresses, _ = net.LookupHost(entry.Ascii)
+	if entry.Status != whoisq.Available {
+		entry.Records = resolver.Resolve(entry.Ascii)
+		entry.Addresses = addresses(entry.Records)
+
 		uniq := make(map[string]bool)
 		for _, addr := range entry.Addresses {
 			names, _ := net.LookupAddr(addr)
@@ -110,80 +161,50 @@ func processEntry(arg async.Job) {
 		for name, _ := range uniq {
 			entry.Names = append(entry.Names, name)
 		}
-	}
-}
 
-func printEntry(entry *Entry) {
-	if entry.Available {
-		if !regOnly && !liveOnly {
-			fmt.Printf("%s (%s) : %s\n", entry.Domain, entry.Ascii, tui.Green("available"))
-		}
-	} else {
-		if !availOnly {
-			mainFields := []string{}
-			whoisFields := []string{}
-			isLive := len(entry.Addresses) > 0
-
-			if isLive {
-				mainFields = append(mainFields, fmt.Sprintf("ips=%s", strings.Join(entry.Addresses, ",")))
-				if len(entry.Names) > 0 {
-					mainFields = append(mainFields, fmt.Sprintf("names=%s", strings.Join(entry.Names, ",")))
-				}
+		isLive := len(entry.Addresses) > 0
+		if isLive && httpProbe {
+			if info, err := fingerprint.ProbeHTTP(entry.Ascii, "https"); err == nil {
+				entry.HTTP = info
+			} else if info, err := fingerprint.ProbeHTTP(entry.Ascii, "http"); err == nil {
+				entry.HTTP = info
 			}
+		}
+		if isLive && tlsProbe {
+			entry.TLS, _ = fingerprint.ProbeTLS(entry.Ascii)
+		}
+	}
 
-			if entry.Whois != nil {
-				if entry.Whois.Registrar != nil {
-					whoisFields = append(whoisFields, fmt.Sprintf("registrar=%s", entry.Whois.Registrar.ReferralURL))
-				}
-
-				if entry.Whois.Domain != nil {
-					whoisFields = append(whoisFields, fmt.Sprintf("created=%s", entry.Whois.Domain.CreatedDate))
-					whoisFields = append(whoisFields, fmt.Sprintf("updated=%s", entry.Whois.Domain.UpdatedDate))
-					whoisFields = append(whoisFields, fmt.Sprintf("expires=%s", entry.Whois.Domain.ExpirationDate))
-					whoisFields = append(whoisFields, fmt.Sprintf("ns=%s", strings.Join(entry.Whois.Domain.NameServers, ",")))
-				}
-			}
-
-			if isLive || !liveOnly {
-				fmt.Printf("%s (%s) %s",
-					entry.Domain,
-					entry.Ascii,
-					tui.Red("registered"))
-
-				if len(mainFields) > 0 {
-					fmt.Printf(" : %s", strings.Join(mainFields, " "))
-				}
-
-				fmt.Println()
-
-				if whoisInfo && len(whoisFields) > 0 {
-					for _, field := range whoisFields {
-						fmt.Printf("  %s\n", field)
-					}
-				}
-			}
+	if jsonlReporter != nil {
+		if err := jsonlReporter.Report(entry); err != nil {
+			die("error writing jsonl entry: %v\n", err)
 		}
 	}
 }
 
-func main() {
-	flag.Parse()
-
-	// the tld library requires the schema or it won't parse the domain ¯\_(ツ)_/¯
-	if !strings.Contains(url, "://") {
-		url = fmt.Sprintf("https://%s", url)
+func reportOptions() report.Options {
+	return report.Options{
+		AvailableOnly:  availOnly,
+		RegisteredOnly: regOnly,
+		LiveOnly:       liveOnly,
+		WhoisInfo:      whoisInfo,
+		HTTPProbe:      httpProbe,
+		TLSProbe:       tlsProbe,
+		ResolverDiff:   resolverDiff,
+		SimilarFavicon: similarFavicon,
 	}
+}
 
-	parsed, err := tld.Parse(url)
-	if err != nil {
-		die("%v\n", err)
-	} else if parsed.Domain == "" {
-		die("could not parse %s\n", url)
-	}
+// runScan generates every permutation of label.suffix and runs the full
+// available/resolve/probe pipeline over them, returning the finished
+// entries. It's the unit of work --watch repeats on a schedule.
+func runScan(label, suffix string) []*Entry {
+	entries = make([]*Entry, 0)
+	queue = async.NewQueue(0, processEntry)
 
-	genEntries(parsed)
+	genEntries(label, suffix)
 
-	fmt.Printf("checking %d variations for '%s.%s', please wait ...\n\n", len(entries), parsed.Domain, parsed.TLD)
+	fmt.Printf("checking %d variations for '%s.%s', please wait ...\n\n", len(entries), label, suffix)
 
 	progress = pb.StartNew(len(entries))
 
@@ -192,13 +213,24 @@ func main() {
 	}
 
 	queue.WaitDone()
-
 	progress.Finish()
 
+	return entries
+}
+
+// writeReports renders entries through the after-the-scan output formats:
+// stdout text (skipped under --jsonl, which processEntry already
+// streamed), plus --csv/--json if set.
+func writeReports(entries []*Entry, opts report.Options) {
 	fmt.Printf("\n\n")
 
-	for _, entry := range entries {
-		printEntry(entry)
+	if !jsonl {
+		text := report.NewTextReporter(os.Stdout, opts)
+		for _, entry := range entries {
+			if err := text.Report(entry); err != nil {
+				die("error writing output: %v\n", err)
+			}
+		}
 	}
 
 	if csvFileName != "" {
@@ -210,74 +242,136 @@ func main() {
 		}
 		defer file.Close()
 
-		writer := csv.NewWriter(file)
-		defer writer.Flush()
+		csvReporter, err := report.NewCSVReporter(file, opts)
+		if err != nil {
+			die("error writing header: %v\n", err)
+		}
 
-		columns := []string {
-			"unicode",
-			"ascii",
-			"status",
-			"ips",
-			"names",
+		for _, entry := range entries {
+			if err := csvReporter.Report(entry); err != nil {
+				die("error writing line: %v\n", err)
+			}
 		}
 
-		if whoisInfo {
-			columns = append(columns, []string{
-				"registrar",
-				"created_at",
-				"updated_at",
-				"expires_at",
-				"nameservers",
-			}...)
+		if err := csvReporter.Close(); err != nil {
+			die("error saving %s: %v\n", csvFileName, err)
 		}
 
-		if err = writer.Write(columns); err != nil {
-			die("error writing header: %v\n", err)
+		fmt.Printf("saved to %s\n", csvFileName)
+	}
+
+	if jsonFileName != "" {
+		file, err := os.Create(jsonFileName)
+		if err != nil {
+			die("error creating %s: %v\n", jsonFileName, err)
 		}
+		defer file.Close()
 
+		jsonReporter := report.NewJSONReporter(file, opts)
 		for _, entry := range entries {
-			row := []string{
-				entry.Domain,
-				entry.Ascii,
-			}
+			jsonReporter.Report(entry)
+		}
+		if err := jsonReporter.Close(); err != nil {
+			die("error saving %s: %v\n", jsonFileName, err)
+		}
 
-			if entry.Available {
-				row = append(row, "available")
-			} else {
-				row = append(row, "registered")
-			}
+		fmt.Printf("saved to %s\n", jsonFileName)
+	}
+}
 
-			row = append(row, strings.Join(entry.Addresses, ","))
-			row = append(row, strings.Join(entry.Names, ","))
-
-			if whoisInfo {
-				if entry.Whois != nil {
-					if entry.Whois.Registrar != nil {
-						row = append(row, entry.Whois.Registrar.ReferralURL)
-					} else {
-						row = append(row, "")
-					}
-
-					if entry.Whois.Domain != nil {
-						row = append(row, entry.Whois.Domain.CreatedDate)
-						row = append(row, entry.Whois.Domain.UpdatedDate)
-						row = append(row, entry.Whois.Domain.ExpirationDate)
-						row = append(row, strings.Join(entry.Whois.Domain.NameServers, ","))
-					} else {
-						row = append(row, []string{
-							"", "", "", ""}...)
-					}
-				} else {
-					row = append(row, []string{
-						"", "", "", "", ""}...)
-				}
-			}
+// runWatch re-runs runScan on interval forever, persisting each pass's
+// entries and alerting cfg's sinks whenever Diff finds a change.
+func runWatch(label, suffix string, interval time.Duration, cfg *monitor.Config) {
+	store, err := monitor.OpenStore(cfg.Store)
+	if err != nil {
+		die("%v\n", err)
+	}
+	defer store.Close()
 
-			if err = writer.Write(row); err != nil {
-				die("error writing line: %v\n", err)
-			}
+	mon := monitor.New(store, cfg.BuildSinks())
+
+	for {
+		scanned := runScan(label, suffix)
+
+		alerts, err := mon.Tick(scanned)
+		if err != nil {
+			fmt.Printf("monitor: %v\n", err)
+		}
+		for _, alert := range alerts {
+			fmt.Printf("[alert] %s: %s\n", alert.Domain, alert.Message)
 		}
 
-		fmt.Printf("saved to %s\n", csvFileName)
+		fmt.Printf("next run in %s ...\n\n", interval)
+		time.Sleep(interval)
+	}
+}
+
+func main() {
+	flag.Parse()
+
+	if similarFavicon != "" {
+		httpProbe = true
+	}
+
+	extra := make([]string, 0)
+	if nameservers != "" {
+		extra = strings.Split(nameservers, ",")
 	}
+	resolver = resolve.New(append(append([]string{}, resolve.DefaultNameservers...), extra...))
+
+	var whoisCacheStore *whoisq.Cache
+	if whoisCache != "" {
+		ttl, err := time.ParseDuration(whoisCacheTTL)
+		if err != nil {
+			die("invalid --whois-cache-ttl: %v\n", err)
+		}
+		whoisCacheStore, err = whoisq.OpenCache(whoisCache, ttl)
+		if err != nil {
+			die("%v\n", err)
+		}
+		defer whoisCacheStore.Close()
+	}
+	whoisClient = whoisq.NewClient(whoisCacheStore, whoisRate, whoisBurst)
+
+	// url.Parse requires the scheme or it won't parse the domain ¯\_(ツ)_/¯
+	if !strings.Contains(domainArg, "://") {
+		domainArg = fmt.Sprintf("https://%s", domainArg)
+	}
+
+	parsed, err := url.Parse(domainArg)
+	if err != nil {
+		die("%v\n", err)
+	}
+
+	label, suffix, err := psl.Split(parsed.Hostname())
+	if err != nil {
+		die("%v\n", err)
+	}
+
+	opts := reportOptions()
+	if jsonl {
+		// Picked up by processEntry, so each entry streams out the
+		// moment it finishes rather than waiting for the whole queue to
+		// drain like every other output format does.
+		jsonlReporter = report.NewJSONLReporter(os.Stdout, opts)
+	}
+
+	if watch != "" {
+		interval, err := time.ParseDuration(watch)
+		if err != nil {
+			die("invalid --watch interval: %v\n", err)
+		}
+		if configPath == "" {
+			die("--watch requires --config\n")
+		}
+		cfg, err := monitor.LoadConfig(configPath)
+		if err != nil {
+			die("%v\n", err)
+		}
+		runWatch(label, suffix, interval, cfg)
+		return
+	}
+
+	scanned := runScan(label, suffix)
+	writeReports(scanned, opts)
 }
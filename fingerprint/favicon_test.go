@@ -0,0 +1,29 @@
+package fingerprint
+
+import "testing"
+
+func TestFaviconHashDeterministic(t *testing.T) {
+	body := []byte("not a real favicon, just fixed bytes for a golden hash")
+
+	first := FaviconHash(body)
+	second := FaviconHash(body)
+	if first != second {
+		t.Fatalf("FaviconHash is not deterministic: %d != %d", first, second)
+	}
+}
+
+func TestFaviconHashDiffersOnContent(t *testing.T) {
+	a := FaviconHash([]byte("favicon a"))
+	b := FaviconHash([]byte("favicon b"))
+	if a == b {
+		t.Fatal("FaviconHash collided on two different inputs")
+	}
+}
+
+func TestWrapBase64(t *testing.T) {
+	got := wrapBase64("abcdefgh", 4)
+	want := "abcd\nefgh\n"
+	if got != want {
+		t.Fatalf("wrapBase64(_, 4) = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,77 @@
+package fingerprint
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// HTTP captures what a single HTTP(S) request to a candidate domain
+// revealed about the server answering it.
+type HTTP struct {
+	Status        int
+	Server        string
+	RedirectChain []string
+	Title         string
+	FaviconHash   string
+}
+
+var client = &http.Client{
+	Timeout: 10 * time.Second,
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		if len(via) >= 10 {
+			return http.ErrUseLastResponse
+		}
+		return nil
+	},
+}
+
+var titleRegexp = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+
+// ProbeHTTP dials domain on the given scheme ("http" or "https") and
+// reports its status, Server header, redirect chain, page title, and
+// favicon hash (see FaviconHash). It never returns a nil *HTTP — a
+// connection failure just leaves the zero-value fields unset — so callers
+// can freely attach the result to an Entry and skip the error.
+func ProbeHTTP(domain, scheme string) (*HTTP, error) {
+	info := &HTTP{}
+
+	url := fmt.Sprintf("%s://%s", scheme, domain)
+	redirects := make([]string, 0)
+	probeClient := *client
+	probeClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		redirects = append(redirects, req.URL.String())
+		return client.CheckRedirect(req, via)
+	}
+
+	resp, err := probeClient.Get(url)
+	if err != nil {
+		return info, err
+	}
+	defer resp.Body.Close()
+
+	info.Status = resp.StatusCode
+	info.Server = resp.Header.Get("Server")
+	info.RedirectChain = redirects
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err == nil {
+		if match := titleRegexp.FindSubmatch(body); match != nil {
+			info.Title = strings.TrimSpace(string(match[1]))
+		}
+	}
+
+	if favicon, err := probeClient.Get(url + "/favicon.ico"); err == nil {
+		defer favicon.Body.Close()
+		if favicon.StatusCode == http.StatusOK {
+			if iconBody, err := io.ReadAll(io.LimitReader(favicon.Body, 1<<20)); err == nil {
+				info.FaviconHash = FaviconHashString(iconBody)
+			}
+		}
+	}
+
+	return info, nil
+}
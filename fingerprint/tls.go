@@ -0,0 +1,60 @@
+package fingerprint
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"time"
+)
+
+// probeTLSTimeout bounds both the TCP connect and the TLS handshake, the
+// same way ProbeHTTP's client.Timeout bounds its requests — without it, a
+// candidate with port 443 filtered or blackholed hangs the probing
+// goroutine forever.
+const probeTLSTimeout = 10 * time.Second
+
+// TLS captures the leaf certificate a server presented for a candidate
+// domain, used to spot squats that were issued a certificate specifically
+// to impersonate the target (the classic phishing-clone setup).
+type TLS struct {
+	Issuer      string
+	Subject     string
+	SANs        []string
+	NotBefore   time.Time
+	NotAfter    time.Time
+	Fingerprint string // SHA-256 of the raw leaf certificate, hex encoded.
+}
+
+// ProbeTLS dials domain on port 443 and reports the leaf certificate's
+// details. InsecureSkipVerify is intentional: we're fingerprinting
+// whatever certificate a squat presents, including self-signed or
+// otherwise untrusted ones, not validating a chain of trust.
+func ProbeTLS(domain string) (*TLS, error) {
+	dialer := &net.Dialer{Timeout: probeTLSTimeout}
+	conn, err := tls.DialWithDialer(dialer, "tcp", domain+":443", &tls.Config{
+		InsecureSkipVerify: true,
+		ServerName:         domain,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	certs := conn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("fingerprint: %s presented no certificate", domain)
+	}
+
+	leaf := certs[0]
+	sum := sha256.Sum256(leaf.Raw)
+
+	return &TLS{
+		Issuer:      leaf.Issuer.String(),
+		Subject:     leaf.Subject.String(),
+		SANs:        leaf.DNSNames,
+		NotBefore:   leaf.NotBefore,
+		NotAfter:    leaf.NotAfter,
+		Fingerprint: fmt.Sprintf("%x", sum),
+	}, nil
+}
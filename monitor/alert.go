@@ -0,0 +1,113 @@
+package monitor
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// Kind identifies what changed about a domain between two monitoring
+// runs.
+type Kind string
+
+const (
+	NewRegistration Kind = "new_registration"
+	RegistrarChange Kind = "registrar_change"
+	IPChange        Kind = "ip_change"
+	NewMXRecord     Kind = "new_mx_record"
+	NewTLSCert      Kind = "new_tls_cert"
+)
+
+// Alert is a single detected change, ready to hand to a Sink.
+type Alert struct {
+	Domain  string    `json:"domain"`
+	Kind    Kind      `json:"kind"`
+	Message string    `json:"message"`
+	Time    time.Time `json:"time"`
+}
+
+// Sink delivers an Alert somewhere a human (or another system) will see
+// it.
+type Sink interface {
+	Send(alert Alert) error
+}
+
+// payloadFunc renders an Alert into the body a particular webhook flavor
+// expects.
+type payloadFunc func(alert Alert) interface{}
+
+// SlackPayload renders alert as a Slack incoming-webhook message.
+func SlackPayload(alert Alert) interface{} {
+	return map[string]string{
+		"text": fmt.Sprintf("[ditto] %s: %s", alert.Domain, alert.Message),
+	}
+}
+
+// DiscordPayload renders alert as a Discord webhook message.
+func DiscordPayload(alert Alert) interface{} {
+	return map[string]string{
+		"content": fmt.Sprintf("**ditto** `%s`: %s", alert.Domain, alert.Message),
+	}
+}
+
+// GenericPayload renders alert as-is, for collectors that just want the
+// structured Alert.
+func GenericPayload(alert Alert) interface{} {
+	return alert
+}
+
+// WebhookSink POSTs a JSON payload to a single URL, shaped by render.
+type WebhookSink struct {
+	URL    string
+	render payloadFunc
+	client *http.Client
+}
+
+// NewWebhookSink returns a WebhookSink that posts to url, rendering each
+// alert with render.
+func NewWebhookSink(url string, render payloadFunc) *WebhookSink {
+	return &WebhookSink{URL: url, render: render, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (s *WebhookSink) Send(alert Alert) error {
+	body, err := json.Marshal(s.render(alert))
+	if err != nil {
+		return fmt.Errorf("monitor: encoding webhook payload: %w", err)
+	}
+
+	resp, err := s.client.Post(s.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("monitor: posting webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("monitor: webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// SMTPSink emails each alert individually via plain SMTP auth.
+type SMTPSink struct {
+	cfg SMTPConfig
+}
+
+// NewSMTPSink returns an SMTPSink configured from cfg.
+func NewSMTPSink(cfg SMTPConfig) *SMTPSink {
+	return &SMTPSink{cfg: cfg}
+}
+
+func (s *SMTPSink) Send(alert Alert) error {
+	addr := fmt.Sprintf("%s:%d", s.cfg.Host, s.cfg.Port)
+	auth := smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)
+
+	subject := fmt.Sprintf("[ditto] %s: %s", alert.Domain, alert.Kind)
+	body := fmt.Sprintf("To: %s\r\nFrom: %s\r\nSubject: %s\r\n\r\n%s\r\n",
+		strings.Join(s.cfg.To, ", "), s.cfg.From, subject, alert.Message)
+
+	return smtp.SendMail(addr, auth, s.cfg.From, s.cfg.To, []byte(body))
+}
@@ -0,0 +1,61 @@
+package monitor
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSlackPayload(t *testing.T) {
+	payload := SlackPayload(Alert{Domain: "squat.com", Message: "is now registered"})
+	got, ok := payload.(map[string]string)
+	if !ok {
+		t.Fatalf("SlackPayload returned %T, want map[string]string", payload)
+	}
+	if want := "[ditto] squat.com: is now registered"; got["text"] != want {
+		t.Fatalf("SlackPayload text = %q, want %q", got["text"], want)
+	}
+}
+
+func TestDiscordPayload(t *testing.T) {
+	payload := DiscordPayload(Alert{Domain: "squat.com", Message: "is now registered"})
+	got, ok := payload.(map[string]string)
+	if !ok {
+		t.Fatalf("DiscordPayload returned %T, want map[string]string", payload)
+	}
+	if want := "**ditto** `squat.com`: is now registered"; got["content"] != want {
+		t.Fatalf("DiscordPayload content = %q, want %q", got["content"], want)
+	}
+}
+
+func TestWebhookSinkPostsRenderedPayload(t *testing.T) {
+	var gotBody map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Errorf("decoding posted body: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, SlackPayload)
+	if err := sink.Send(Alert{Domain: "squat.com", Message: "is now registered"}); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	if want := "[ditto] squat.com: is now registered"; gotBody["text"] != want {
+		t.Fatalf("posted text = %q, want %q", gotBody["text"], want)
+	}
+}
+
+func TestWebhookSinkErrorsOnNonSuccessStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, SlackPayload)
+	if err := sink.Send(Alert{Domain: "squat.com"}); err == nil {
+		t.Fatal("Send returned nil error for a 500 response")
+	}
+}
@@ -0,0 +1,79 @@
+// Package monitor turns ditto into a long-running daemon: it re-runs the
+// full permutation/resolution/probe pipeline on a schedule, persists
+// results between runs, and alerts pluggable sinks when something about a
+// squat changes.
+package monitor
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the on-disk --config file: where to persist prior results and
+// which sinks to alert through when something changes.
+type Config struct {
+	// Store is the path to the on-disk BoltDB database used to remember
+	// prior runs. Defaults to "ditto.db" when empty.
+	Store string `yaml:"store"`
+
+	Sinks struct {
+		Slack   *WebhookConfig `yaml:"slack"`
+		Discord *WebhookConfig `yaml:"discord"`
+		HTTP    *WebhookConfig `yaml:"http"`
+		SMTP    *SMTPConfig    `yaml:"smtp"`
+	} `yaml:"sinks"`
+}
+
+// WebhookConfig configures a sink that POSTs a JSON payload to a single
+// URL — enough for Slack incoming webhooks, Discord webhooks, and any
+// generic HTTP collector.
+type WebhookConfig struct {
+	URL string `yaml:"url"`
+}
+
+// SMTPConfig configures the SMTP sink.
+type SMTPConfig struct {
+	Host     string   `yaml:"host"`
+	Port     int      `yaml:"port"`
+	Username string   `yaml:"username"`
+	Password string   `yaml:"password"`
+	From     string   `yaml:"from"`
+	To       []string `yaml:"to"`
+}
+
+// LoadConfig reads and parses a YAML config file from path.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("monitor: reading config: %w", err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("monitor: parsing config: %w", err)
+	}
+	if cfg.Store == "" {
+		cfg.Store = "ditto.db"
+	}
+	return &cfg, nil
+}
+
+// BuildSinks builds the list of alert Sinks configured in cfg.
+func (cfg *Config) BuildSinks() []Sink {
+	sinks := make([]Sink, 0)
+	if cfg.Sinks.Slack != nil {
+		sinks = append(sinks, NewWebhookSink(cfg.Sinks.Slack.URL, SlackPayload))
+	}
+	if cfg.Sinks.Discord != nil {
+		sinks = append(sinks, NewWebhookSink(cfg.Sinks.Discord.URL, DiscordPayload))
+	}
+	if cfg.Sinks.HTTP != nil {
+		sinks = append(sinks, NewWebhookSink(cfg.Sinks.HTTP.URL, GenericPayload))
+	}
+	if cfg.Sinks.SMTP != nil {
+		sinks = append(sinks, NewSMTPSink(*cfg.Sinks.SMTP))
+	}
+	return sinks
+}
@@ -0,0 +1,86 @@
+package monitor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeConfig(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "ditto.yaml")
+	if err := os.WriteFile(path, []byte(contents), 0600); err != nil {
+		t.Fatalf("writing config fixture: %v", err)
+	}
+	return path
+}
+
+func TestLoadConfigDefaultsStore(t *testing.T) {
+	path := writeConfig(t, "sinks:\n  slack:\n    url: https://example.com/webhook\n")
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if cfg.Store != "ditto.db" {
+		t.Fatalf("Store = %q, want default %q", cfg.Store, "ditto.db")
+	}
+}
+
+func TestLoadConfigExplicitStore(t *testing.T) {
+	path := writeConfig(t, "store: squats.db\n")
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if cfg.Store != "squats.db" {
+		t.Fatalf("Store = %q, want %q", cfg.Store, "squats.db")
+	}
+}
+
+func TestBuildSinksOnePerConfiguredSink(t *testing.T) {
+	path := writeConfig(t, `
+sinks:
+  slack:
+    url: https://example.com/slack
+  discord:
+    url: https://example.com/discord
+  http:
+    url: https://example.com/generic
+  smtp:
+    host: smtp.example.com
+    port: 587
+`)
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+
+	sinks := cfg.BuildSinks()
+	if len(sinks) != 4 {
+		t.Fatalf("BuildSinks returned %d sinks, want 4", len(sinks))
+	}
+
+	if _, ok := sinks[3].(*SMTPSink); !ok {
+		t.Fatalf("sinks[3] = %T, want *SMTPSink", sinks[3])
+	}
+	for i, want := range []string{"*monitor.WebhookSink", "*monitor.WebhookSink", "*monitor.WebhookSink"} {
+		if _, ok := sinks[i].(*WebhookSink); !ok {
+			t.Fatalf("sinks[%d] = %T, want %s", i, sinks[i], want)
+		}
+	}
+}
+
+func TestBuildSinksNoneConfigured(t *testing.T) {
+	path := writeConfig(t, "store: squats.db\n")
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if sinks := cfg.BuildSinks(); len(sinks) != 0 {
+		t.Fatalf("BuildSinks = %v, want none", sinks)
+	}
+}
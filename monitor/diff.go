@@ -0,0 +1,123 @@
+package monitor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/krishpranav/ditto/report"
+	"github.com/krishpranav/ditto/whoisq"
+)
+
+// Entry is an alias for report.Entry so the rest of this package doesn't
+// need to import report directly.
+type Entry = report.Entry
+
+// Diff compares an entry's previously persisted state against its
+// current one and returns every change worth alerting on. prev is nil on
+// a domain's first observed run, in which case only a fresh registration
+// is newsworthy — everything else needs a baseline to differ from.
+func Diff(prev, curr *Entry) []Alert {
+	alerts := make([]Alert, 0)
+
+	if prev == nil {
+		if curr.Status == whoisq.Registered {
+			alerts = append(alerts, Alert{
+				Domain:  curr.Domain,
+				Kind:    NewRegistration,
+				Message: fmt.Sprintf("%s is now registered", curr.Domain),
+				Time:    time.Now(),
+			})
+		}
+		return alerts
+	}
+
+	if prev.Status != whoisq.Registered && curr.Status == whoisq.Registered {
+		alerts = append(alerts, Alert{
+			Domain:  curr.Domain,
+			Kind:    NewRegistration,
+			Message: fmt.Sprintf("%s is now registered", curr.Domain),
+			Time:    time.Now(),
+		})
+	}
+
+	if registrar(prev) != "" && registrar(curr) != "" && registrar(prev) != registrar(curr) {
+		alerts = append(alerts, Alert{
+			Domain:  curr.Domain,
+			Kind:    RegistrarChange,
+			Message: fmt.Sprintf("registrar changed from %q to %q", registrar(prev), registrar(curr)),
+			Time:    time.Now(),
+		})
+	}
+
+	if added := setDiff(prev.Addresses, curr.Addresses); len(added) > 0 {
+		alerts = append(alerts, Alert{
+			Domain:  curr.Domain,
+			Kind:    IPChange,
+			Message: fmt.Sprintf("new IP addresses: %v", added),
+			Time:    time.Now(),
+		})
+	}
+
+	if added := setDiff(mxRecords(prev), mxRecords(curr)); len(added) > 0 {
+		alerts = append(alerts, Alert{
+			Domain:  curr.Domain,
+			Kind:    NewMXRecord,
+			Message: fmt.Sprintf("new MX records: %v", added),
+			Time:    time.Now(),
+		})
+	}
+
+	if curr.TLS != nil && (prev.TLS == nil || prev.TLS.Fingerprint != curr.TLS.Fingerprint) {
+		alerts = append(alerts, Alert{
+			Domain:  curr.Domain,
+			Kind:    NewTLSCert,
+			Message: fmt.Sprintf("new TLS certificate fingerprint: %s", curr.TLS.Fingerprint),
+			Time:    time.Now(),
+		})
+	}
+
+	return alerts
+}
+
+func registrar(entry *Entry) string {
+	if entry == nil || entry.Whois == nil || entry.Whois.Registrar == nil {
+		return ""
+	}
+	return entry.Whois.Registrar.ReferralURL
+}
+
+// mxRecords collects the MX answers seen across every resolver, since any
+// one of them newly seeing an MX record is itself a phishing precursor
+// worth flagging.
+func mxRecords(entry *Entry) []string {
+	if entry == nil {
+		return nil
+	}
+	uniq := make(map[string]bool)
+	for _, records := range entry.Records {
+		for _, mx := range records["MX"] {
+			uniq[mx] = true
+		}
+	}
+	out := make([]string, 0, len(uniq))
+	for mx := range uniq {
+		out = append(out, mx)
+	}
+	return out
+}
+
+// setDiff returns the elements of next that aren't present in prev.
+func setDiff(prev, next []string) []string {
+	seen := make(map[string]bool, len(prev))
+	for _, p := range prev {
+		seen[p] = true
+	}
+
+	added := make([]string, 0)
+	for _, n := range next {
+		if !seen[n] {
+			added = append(added, n)
+		}
+	}
+	return added
+}
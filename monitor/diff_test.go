@@ -0,0 +1,58 @@
+package monitor
+
+import (
+	"testing"
+
+	"github.com/krishpranav/ditto/fingerprint"
+	"github.com/krishpranav/ditto/whoisq"
+)
+
+func TestDiffNewRegistration(t *testing.T) {
+	curr := &Entry{Domain: "squat.com", Status: whoisq.Registered}
+	alerts := Diff(nil, curr)
+
+	if len(alerts) != 1 || alerts[0].Kind != NewRegistration {
+		t.Fatalf("Diff(nil, registered) = %v, want one NewRegistration alert", alerts)
+	}
+}
+
+func TestDiffStillAvailableRaisesNothing(t *testing.T) {
+	curr := &Entry{Domain: "squat.com", Status: whoisq.Available}
+	if alerts := Diff(nil, curr); len(alerts) != 0 {
+		t.Fatalf("Diff(nil, available) = %v, want no alerts", alerts)
+	}
+}
+
+func TestDiffIPChange(t *testing.T) {
+	prev := &Entry{Domain: "squat.com", Status: whoisq.Registered, Addresses: []string{"1.1.1.1"}}
+	curr := &Entry{Domain: "squat.com", Status: whoisq.Registered, Addresses: []string{"1.1.1.1", "2.2.2.2"}}
+
+	alerts := Diff(prev, curr)
+	if len(alerts) != 1 || alerts[0].Kind != IPChange {
+		t.Fatalf("Diff(prev, curr) = %v, want one IPChange alert", alerts)
+	}
+}
+
+func TestDiffNoChangeRaisesNothing(t *testing.T) {
+	entry := &Entry{Domain: "squat.com", Status: whoisq.Registered, Addresses: []string{"1.1.1.1"}}
+	if alerts := Diff(entry, entry); len(alerts) != 0 {
+		t.Fatalf("Diff(entry, entry) = %v, want no alerts", alerts)
+	}
+}
+
+func TestDiffNewTLSCert(t *testing.T) {
+	prev := &Entry{Domain: "squat.com", TLS: &fingerprint.TLS{Fingerprint: "aaa"}}
+	curr := &Entry{Domain: "squat.com", TLS: &fingerprint.TLS{Fingerprint: "bbb"}}
+
+	alerts := Diff(prev, curr)
+	if len(alerts) != 1 || alerts[0].Kind != NewTLSCert {
+		t.Fatalf("Diff(prev, curr) = %v, want one NewTLSCert alert", alerts)
+	}
+}
+
+func TestSetDiff(t *testing.T) {
+	added := setDiff([]string{"a", "b"}, []string{"b", "c"})
+	if len(added) != 1 || added[0] != "c" {
+		t.Fatalf("setDiff = %v, want [c]", added)
+	}
+}
@@ -0,0 +1,60 @@
+package monitor
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Monitor diffs each pass of a scan, entry by entry, against the last time
+// each entry was seen and dispatches alerts for whatever changed. It does
+// not schedule scans itself; the caller runs a scan and calls Tick for
+// each pass.
+type Monitor struct {
+	Store *Store
+	Sinks []Sink
+}
+
+// New returns a Monitor persisting to store and alerting through sinks.
+func New(store *Store, sinks []Sink) *Monitor {
+	return &Monitor{Store: store, Sinks: sinks}
+}
+
+// Tick runs one monitoring pass over entries: for each one, it loads the
+// prior state, diffs against the current state, dispatches any resulting
+// alerts, and persists the current state for next time. It returns every
+// alert raised this pass, mainly so callers can log a summary.
+//
+// A failure loading, alerting, or saving one entry is logged into the
+// returned error but doesn't stop the rest of the batch — a single
+// transient sink or store error shouldn't abandon monitoring for every
+// other candidate domain in the pass.
+func (m *Monitor) Tick(entries []*Entry) ([]Alert, error) {
+	raised := make([]Alert, 0)
+	var errs []error
+
+	for _, entry := range entries {
+		prev, found, err := m.Store.Load(entry.Domain)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("monitor: loading prior state for %s: %w", entry.Domain, err))
+			continue
+		}
+		if !found {
+			prev = nil
+		}
+
+		for _, alert := range Diff(prev, entry) {
+			raised = append(raised, alert)
+			for _, sink := range m.Sinks {
+				if err := sink.Send(alert); err != nil {
+					errs = append(errs, fmt.Errorf("monitor: sending alert for %s: %w", entry.Domain, err))
+				}
+			}
+		}
+
+		if err := m.Store.Save(entry); err != nil {
+			errs = append(errs, fmt.Errorf("monitor: saving state for %s: %w", entry.Domain, err))
+		}
+	}
+
+	return raised, errors.Join(errs...)
+}
@@ -0,0 +1,70 @@
+package monitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/krishpranav/ditto/report"
+	bolt "go.etcd.io/bbolt"
+)
+
+var entriesBucket = []byte("entries")
+
+// Store persists the last-seen state of every candidate domain between
+// monitoring runs, so Diff has something to compare the current run
+// against.
+type Store struct {
+	db *bolt.DB
+}
+
+// OpenStore opens (creating if necessary) a BoltDB database at path.
+func OpenStore(path string) (*Store, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("monitor: opening store: %w", err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(entriesBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("monitor: initializing store: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Load returns the previously persisted entry for domain, if any.
+func (s *Store) Load(domain string) (entry *report.Entry, found bool, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		raw := tx.Bucket(entriesBucket).Get([]byte(domain))
+		if raw == nil {
+			return nil
+		}
+		found = true
+		entry = &report.Entry{}
+		return json.Unmarshal(raw, entry)
+	})
+	return entry, found, err
+}
+
+// Save persists entry, replacing whatever was previously stored for the
+// same domain.
+func (s *Store) Save(entry *report.Entry) error {
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("monitor: encoding entry: %w", err)
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(entriesBucket).Put([]byte(entry.Domain), raw)
+	})
+}
+
+// Close releases the database file.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,49 @@
+package monitor
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/krishpranav/ditto/report"
+	"github.com/krishpranav/ditto/whoisq"
+)
+
+func TestStoreSaveLoadRoundTrip(t *testing.T) {
+	store, err := OpenStore(filepath.Join(t.TempDir(), "ditto.db"))
+	if err != nil {
+		t.Fatalf("OpenStore: %v", err)
+	}
+	defer store.Close()
+
+	entry := &report.Entry{Domain: "squat.com", Status: whoisq.Registered, Addresses: []string{"1.2.3.4"}}
+	if err := store.Save(entry); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	got, found, err := store.Load("squat.com")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if !found {
+		t.Fatal("Load reported not found for a saved entry")
+	}
+	if got.Domain != entry.Domain || got.Status != entry.Status {
+		t.Fatalf("Load = %+v, want %+v", got, entry)
+	}
+}
+
+func TestStoreLoadMissingDomain(t *testing.T) {
+	store, err := OpenStore(filepath.Join(t.TempDir(), "ditto.db"))
+	if err != nil {
+		t.Fatalf("OpenStore: %v", err)
+	}
+	defer store.Close()
+
+	got, found, err := store.Load("never-saved.com")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if found || got != nil {
+		t.Fatalf("Load(never-saved.com) = %+v, %v, want nil, false", got, found)
+	}
+}
@@ -0,0 +1,46 @@
+package permute
+
+import "fmt"
+
+// Bitsquat flips, one at a time, each bit of each ASCII byte in label. A
+// bit-squat is a domain that would only be registered by a client whose
+// hardware suffered a single-bit memory error while resolving the
+// legitimate name. Non-ASCII bytes are left untouched since a flipped bit
+// there would likely break UTF-8 encoding entirely.
+func Bitsquat(label, tld string, opts Options) []Entry {
+	entries := make([]Entry, 0)
+	bytes := []byte(label)
+	for i, b := range bytes {
+		if b >= 0x80 {
+			continue
+		}
+		for bit := uint(0); bit < 8; bit++ {
+			flipped := b ^ (1 << bit)
+			// Keep the result a plausible DNS label character.
+			if !isLabelByte(flipped) {
+				continue
+			}
+			mutated := append([]byte{}, bytes...)
+			mutated[i] = flipped
+			entries = append(entries, Entry{
+				Domain:   fmt.Sprintf("%s.%s", string(mutated), tld),
+				Strategy: "bitsquat",
+			})
+		}
+	}
+	return entries
+}
+
+// isLabelByte reports whether b is valid in a DNS label: a-z, 0-9, or '-'.
+func isLabelByte(b byte) bool {
+	switch {
+	case b >= 'a' && b <= 'z':
+		return true
+	case b >= '0' && b <= '9':
+		return true
+	case b == '-':
+		return true
+	default:
+		return false
+	}
+}
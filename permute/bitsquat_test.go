@@ -0,0 +1,29 @@
+package permute
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBitsquat(t *testing.T) {
+	// 'a' is 0x61 = 0b0110_0001. Flipping each bit in turn and keeping
+	// only results that are still valid DNS-label bytes (a-z, 0-9, '-')
+	// yields a small, fixed set of candidates.
+	got := domains(Bitsquat("a", "com", Options{}))
+	want := []string{"c.com", "e.com", "i.com", "q.com"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Bitsquat(\"a\", \"com\") = %q, want %q", got, want)
+	}
+}
+
+func TestIsLabelByte(t *testing.T) {
+	cases := map[byte]bool{
+		'a': true, 'z': true, '0': true, '9': true, '-': true,
+		'A': false, '!': false, '`': false,
+	}
+	for b, want := range cases {
+		if got := isLabelByte(b); got != want {
+			t.Errorf("isLabelByte(%q) = %v, want %v", b, got, want)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package permute
+
+import "fmt"
+
+// commonBrandWords is a curated list of words commonly appended to a
+// hijacked brand name in phishing domains.
+var commonBrandWords = []string{
+	"login", "secure", "support", "verify", "account", "update", "alert",
+}
+
+// Hyphenation inserts a hyphen at every internal position of label, e.g.
+// "google" -> "go-ogle", "goo-gle".
+func Hyphenation(label, tld string, opts Options) []Entry {
+	entries := make([]Entry, 0)
+	runes := []rune(label)
+	for i := 1; i < len(runes); i++ {
+		mutated := string(runes[:i]) + "-" + string(runes[i:])
+		entries = append(entries, Entry{
+			Domain:   fmt.Sprintf("%s.%s", mutated, tld),
+			Strategy: "hyphenation",
+		})
+	}
+	return entries
+}
+
+// SubdomainInjection turns label into a fake subdomain of itself, e.g.
+// "ice.gov" -> "ice-gov.com", "www-ice.gov", exploiting users who only
+// glance at the start of a long hostname.
+func SubdomainInjection(label, tld string, opts Options) []Entry {
+	tlds := opts.TLDs
+	if len(tlds) == 0 {
+		tlds = commonTLDs
+	}
+
+	entries := make([]Entry, 0)
+	for _, candidate := range tlds {
+		entries = append(entries, Entry{
+			Domain:   fmt.Sprintf("%s-%s.%s", label, tld, candidate),
+			Strategy: "subdomain",
+		})
+	}
+	entries = append(entries, Entry{
+		Domain:   fmt.Sprintf("www-%s.%s", label, tld),
+		Strategy: "subdomain",
+	})
+	return entries
+}
+
+// BrandAppend appends, with a hyphen, each of opts.BrandWords (default
+// commonBrandWords) to label, e.g. "ice" -> "ice-login", "ice-secure".
+func BrandAppend(label, tld string, opts Options) []Entry {
+	words := opts.BrandWords
+	if len(words) == 0 {
+		words = commonBrandWords
+	}
+
+	entries := make([]Entry, 0)
+	for _, word := range words {
+		entries = append(entries, Entry{
+			Domain:   fmt.Sprintf("%s-%s.%s", label, word, tld),
+			Strategy: "brand",
+		})
+	}
+	return entries
+}
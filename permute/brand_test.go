@@ -0,0 +1,30 @@
+package permute
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHyphenation(t *testing.T) {
+	got := domains(Hyphenation("abc", "com", Options{}))
+	want := []string{"a-bc.com", "ab-c.com"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Hyphenation(\"abc\", \"com\") = %q, want %q", got, want)
+	}
+}
+
+func TestSubdomainInjection(t *testing.T) {
+	got := domains(SubdomainInjection("ice", "gov", Options{TLDs: []string{"com"}}))
+	want := []string{"ice-gov.com", "www-ice.gov"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("SubdomainInjection(\"ice\", \"gov\") = %q, want %q", got, want)
+	}
+}
+
+func TestBrandAppend(t *testing.T) {
+	got := domains(BrandAppend("ice", "gov", Options{BrandWords: []string{"login", "secure"}}))
+	want := []string{"ice-login.gov", "ice-secure.gov"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("BrandAppend(\"ice\", \"gov\") = %q, want %q", got, want)
+	}
+}
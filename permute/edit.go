@@ -0,0 +1,80 @@
+package permute
+
+import "fmt"
+
+// alphabet is the character set Insertion tries at each position. It's kept
+// to lowercase ASCII letters and digits, which covers the vast majority of
+// real-world squats without exploding the candidate count.
+var alphabet = []rune("abcdefghijklmnopqrstuvwxyz0123456789")
+
+// Omission drops one character of label at a time, e.g. "google" ->
+// "goole", "gogle".
+func Omission(label, tld string, opts Options) []Entry {
+	entries := make([]Entry, 0)
+	runes := []rune(label)
+	if len(runes) < 2 {
+		return entries
+	}
+	for i := range runes {
+		mutated := append(append([]rune{}, runes[:i]...), runes[i+1:]...)
+		entries = append(entries, Entry{
+			Domain:   fmt.Sprintf("%s.%s", string(mutated), tld),
+			Strategy: "omission",
+		})
+	}
+	return entries
+}
+
+// Insertion inserts one extra character at every position of label, for
+// every letter in alphabet, e.g. "google" -> "gooogle", "googlle".
+func Insertion(label, tld string, opts Options) []Entry {
+	entries := make([]Entry, 0)
+	runes := []rune(label)
+	for i := 0; i <= len(runes); i++ {
+		for _, c := range alphabet {
+			mutated := make([]rune, 0, len(runes)+1)
+			mutated = append(mutated, runes[:i]...)
+			mutated = append(mutated, c)
+			mutated = append(mutated, runes[i:]...)
+			entries = append(entries, Entry{
+				Domain:   fmt.Sprintf("%s.%s", string(mutated), tld),
+				Strategy: "insertion",
+			})
+		}
+	}
+	return entries
+}
+
+// Transposition swaps each pair of adjacent characters in label, e.g.
+// "google" -> "ogogle", "gogole".
+func Transposition(label, tld string, opts Options) []Entry {
+	entries := make([]Entry, 0)
+	runes := []rune(label)
+	for i := 0; i+1 < len(runes); i++ {
+		mutated := append([]rune{}, runes...)
+		mutated[i], mutated[i+1] = mutated[i+1], mutated[i]
+		entries = append(entries, Entry{
+			Domain:   fmt.Sprintf("%s.%s", string(mutated), tld),
+			Strategy: "transposition",
+		})
+	}
+	return entries
+}
+
+// Duplication doubles one character of label at a time, e.g. "google" ->
+// "ggoogle", "gooogle".
+func Duplication(label, tld string, opts Options) []Entry {
+	entries := make([]Entry, 0)
+	runes := []rune(label)
+	for i, c := range runes {
+		mutated := make([]rune, 0, len(runes)+1)
+		mutated = append(mutated, runes[:i]...)
+		mutated = append(mutated, c, c)
+		mutated = append(mutated, runes[i+1:]...)
+		entries = append(entries, Entry{
+			Domain:   fmt.Sprintf("%s.%s", string(mutated), tld),
+			Strategy: "duplication",
+		})
+	}
+	return entries
+}
@@ -0,0 +1,62 @@
+package permute
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOmission(t *testing.T) {
+	got := domains(Omission("abc", "com", Options{}))
+	want := []string{"bc.com", "ac.com", "ab.com"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Omission(\"abc\", \"com\") = %q, want %q", got, want)
+	}
+}
+
+func TestOmissionTooShort(t *testing.T) {
+	if got := Omission("a", "com", Options{}); len(got) != 0 {
+		t.Fatalf("Omission(\"a\", \"com\") = %v, want no entries", got)
+	}
+}
+
+func TestTransposition(t *testing.T) {
+	got := domains(Transposition("abc", "com", Options{}))
+	want := []string{"bac.com", "acb.com"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Transposition(\"abc\", \"com\") = %q, want %q", got, want)
+	}
+}
+
+func TestDuplication(t *testing.T) {
+	got := domains(Duplication("ab", "com", Options{}))
+	want := []string{"aab.com", "abb.com"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Duplication(\"ab\", \"com\") = %q, want %q", got, want)
+	}
+}
+
+func TestInsertion(t *testing.T) {
+	got := domains(Insertion("a", "com", Options{}))
+	want := []string{
+		// i=0: alphabet char prepended before "a"
+		"aa.com", "ba.com", "ca.com", "da.com", "ea.com", "fa.com", "ga.com",
+		"ha.com", "ia.com", "ja.com", "ka.com", "la.com", "ma.com", "na.com",
+		"oa.com", "pa.com", "qa.com", "ra.com", "sa.com", "ta.com", "ua.com",
+		"va.com", "wa.com", "xa.com", "ya.com", "za.com", "0a.com", "1a.com",
+		"2a.com", "3a.com", "4a.com", "5a.com", "6a.com", "7a.com", "8a.com",
+		"9a.com",
+		// i=1: alphabet char appended after "a"
+		"aa.com", "ab.com", "ac.com", "ad.com", "ae.com", "af.com", "ag.com",
+		"ah.com", "ai.com", "aj.com", "ak.com", "al.com", "am.com", "an.com",
+		"ao.com", "ap.com", "aq.com", "ar.com", "as.com", "at.com", "au.com",
+		"av.com", "aw.com", "ax.com", "ay.com", "az.com", "a0.com", "a1.com",
+		"a2.com", "a3.com", "a4.com", "a5.com", "a6.com", "a7.com", "a8.com",
+		"a9.com",
+	}
+	if len(got) != len(alphabet)*2 {
+		t.Fatalf("Insertion(\"a\", \"com\") produced %d entries, want %d", len(got), len(alphabet)*2)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Insertion(\"a\", \"com\") = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,26 @@
+package permute
+
+import "testing"
+
+func TestNewEngineUnknownStrategy(t *testing.T) {
+	_, err := NewEngine([]string{"bogus"}, Options{})
+	if err == nil {
+		t.Fatal("NewEngine with an unknown strategy name should error")
+	}
+}
+
+func TestEngineGenerateOrder(t *testing.T) {
+	engine, err := NewEngine([]string{"tld", "brand"}, Options{
+		TLDs:       []string{"net"},
+		BrandWords: []string{"login"},
+	})
+	if err != nil {
+		t.Fatalf("NewEngine: %v", err)
+	}
+
+	got := domains(engine.Generate("ice", "gov"))
+	want := []string{"ice.net", "ice-login.gov"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("Engine.Generate(\"ice\", \"gov\") = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,63 @@
+package permute
+
+import "fmt"
+
+// confusables is a hand-picked shortlist of Unicode lookalikes for the
+// Latin letters most often abused in homoglyph attacks, not a generated
+// mirror of the full Unicode IDN confusables table
+// (https://www.unicode.org/Public/security/latest/confusables.txt). That
+// table runs to thousands of mappings across every script; encoding all
+// of them here would blow up candidate counts for marginal benefit, so
+// this list deliberately sticks to a few dozen entries covering the
+// substitutions attackers actually use and omits combining marks and
+// rarely-rendered scripts entirely.
+var confusables = map[rune][]rune{
+	'a': {'а', 'ａ', 'ɑ'}, // Cyrillic а, fullwidth a, Latin alpha
+	'b': {'Ь', 'ｂ'},
+	'c': {'с', 'ϲ', 'ｃ'}, // Cyrillic с, Greek lunate sigma
+	'd': {'ԁ', 'ｄ'},
+	'e': {'е', 'ｅ'}, // Cyrillic е
+	'g': {'ɡ', 'ｇ'},
+	'h': {'һ', 'ｈ'},
+	'i': {'і', 'ｉ', 'l', '1'}, // Cyrillic і
+	'j': {'ј', 'ｊ'},
+	'k': {'κ', 'ｋ'},
+	'l': {'1', 'і', 'ｌ'},
+	'm': {'ｍ'},
+	'n': {'ո', 'ｎ'},
+	'o': {'о', '0', 'ｏ'}, // Cyrillic о
+	'p': {'р', 'ｐ'},      // Cyrillic р
+	'q': {'ｑ'},
+	'r': {'ｒ'},
+	's': {'ѕ', 'ｓ'}, // Cyrillic ѕ
+	't': {'ｔ'},
+	'u': {'υ', 'ｕ'}, // Greek upsilon
+	'v': {'ν', 'ｖ'}, // Greek nu
+	'w': {'ѡ', 'ｗ'}, // Cyrillic ѡ
+	'x': {'х', 'ｘ'}, // Cyrillic х
+	'y': {'у', 'ｙ'}, // Cyrillic у
+	'z': {'ｚ'},
+}
+
+// Homoglyph replaces, one at a time, each character of label with a
+// visually similar Unicode confusable.
+func Homoglyph(label, tld string, opts Options) []Entry {
+	entries := make([]Entry, 0)
+	runes := []rune(label)
+	for i, c := range runes {
+		subs, found := confusables[c]
+		if !found {
+			continue
+		}
+		for _, sub := range subs {
+			mutated := make([]rune, len(runes))
+			copy(mutated, runes)
+			mutated[i] = sub
+			entries = append(entries, Entry{
+				Domain:   fmt.Sprintf("%s.%s", string(mutated), tld),
+				Strategy: "homoglyph",
+			})
+		}
+	}
+	return entries
+}
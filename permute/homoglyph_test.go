@@ -0,0 +1,43 @@
+package permute
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func domains(entries []Entry) []string {
+	out := make([]string, len(entries))
+	for i, e := range entries {
+		out[i] = e.Domain
+	}
+	return out
+}
+
+func TestHomoglyph(t *testing.T) {
+	got := domains(Homoglyph("go", "com", Options{}))
+
+	want := make([]string, 0)
+	for i, c := range []rune("go") {
+		runes := []rune("go")
+		for _, sub := range confusables[c] {
+			mutated := append([]rune{}, runes...)
+			mutated[i] = sub
+			want = append(want, fmt.Sprintf("%s.com", string(mutated)))
+		}
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Homoglyph(\"go\", \"com\") = %q, want %q", got, want)
+	}
+	if len(got) == 0 {
+		t.Fatal("Homoglyph produced no candidates for a label with confusable letters")
+	}
+}
+
+func TestHomoglyphUnknownRune(t *testing.T) {
+	got := Homoglyph("9", "com", Options{})
+	if len(got) != 0 {
+		t.Fatalf("Homoglyph(\"9\", \"com\") = %v, want no entries", got)
+	}
+}
@@ -0,0 +1,65 @@
+package permute
+
+import "fmt"
+
+// qwertyAdjacency maps each key to its physical neighbours on a standard
+// US QWERTY layout.
+var qwertyAdjacency = map[rune]string{
+	'a': "qwsz", 'b': "vghn", 'c': "xdfv", 'd': "serfcx", 'e': "wsdr",
+	'f': "drtgvc", 'g': "ftyhbv", 'h': "gyujnb", 'i': "ujko", 'j': "huikmn",
+	'k': "jiolm", 'l': "kop", 'm': "njk", 'n': "bhjm", 'o': "iklp",
+	'p': "ol", 'q': "wa", 'r': "edft", 's': "awedxz", 't': "rfgy",
+	'u': "yhji", 'v': "cfgb", 'w': "qase", 'x': "zsdc", 'y': "tghu",
+	'z': "asx",
+}
+
+// azertyAdjacency maps each key to its physical neighbours on a standard
+// French AZERTY layout, where the top row starts "azerty" and "q"/"w" sit
+// where "a"/"z" do on QWERTY.
+var azertyAdjacency = map[rune]string{
+	'a': "zq", 'b': "vghn", 'c': "xdfv", 'd': "sferxc", 'e': "zsdr",
+	'f': "drtgvc", 'g': "ftyhbv", 'h': "gyujnb", 'i': "ujko", 'j': "huikmn",
+	'k': "jiolm", 'l': "kop", 'm': "lk", 'n': "bhj", 'o': "iklp",
+	'p': "ol", 'q': "saz", 'r': "edft", 's': "qzedxw", 't': "rfgy",
+	'u': "yhji", 'v': "cfgb", 'w': "xs", 'x': "wsdc", 'y': "tghu",
+	'z': "aeqs",
+}
+
+var keyboards = map[string]map[rune]string{
+	"qwerty": qwertyAdjacency,
+	"azerty": azertyAdjacency,
+}
+
+// Keyboard swaps each character of label with one of its neighbours on the
+// requested keyboard layouts (opts.Keyboards, default "qwerty" and
+// "azerty"), simulating a typo from a fat-fingered adjacent key.
+func Keyboard(label, tld string, opts Options) []Entry {
+	entries := make([]Entry, 0)
+	layouts := opts.Keyboards
+	if len(layouts) == 0 {
+		layouts = []string{"qwerty", "azerty"}
+	}
+
+	runes := []rune(label)
+	for _, layout := range layouts {
+		adjacency, found := keyboards[layout]
+		if !found {
+			continue
+		}
+		for i, c := range runes {
+			neighbours, found := adjacency[c]
+			if !found {
+				continue
+			}
+			for _, n := range neighbours {
+				mutated := append([]rune{}, runes...)
+				mutated[i] = n
+				entries = append(entries, Entry{
+					Domain:   fmt.Sprintf("%s.%s", string(mutated), tld),
+					Strategy: "keyboard",
+				})
+			}
+		}
+	}
+	return entries
+}
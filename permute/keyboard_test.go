@@ -0,0 +1,21 @@
+package permute
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKeyboardQWERTYOnly(t *testing.T) {
+	got := domains(Keyboard("a", "com", Options{Keyboards: []string{"qwerty"}}))
+	want := []string{"q.com", "w.com", "s.com", "z.com"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Keyboard(\"a\", \"com\") = %q, want %q", got, want)
+	}
+}
+
+func TestKeyboardUnknownLayoutIgnored(t *testing.T) {
+	got := Keyboard("a", "com", Options{Keyboards: []string{"dvorak"}})
+	if len(got) != 0 {
+		t.Fatalf("Keyboard with unknown layout = %v, want no entries", got)
+	}
+}
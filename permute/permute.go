@@ -0,0 +1,108 @@
+// Package permute generates domain-name permutations ("candidates") for a
+// given label and TLD using a set of independently selectable strategies
+// (homoglyph substitution, keyboard-adjacency swaps, bit-squatting, TLD
+// swapping, and so on). Strategies are looked up by name so callers can let
+// the user pick a subset via a CLI flag.
+package permute
+
+import "sort"
+
+// Entry is a single generated candidate domain.
+type Entry struct {
+	// Domain is the full candidate, e.g. "app1e.com".
+	Domain string
+	// Strategy is the name of the strategy that produced this entry.
+	Strategy string
+}
+
+// Options carries the inputs a strategy needs beyond the label/TLD being
+// permuted. Strategies that don't need a given field simply ignore it.
+type Options struct {
+	// Dictionary maps a rune to its lookalike substitutes, used by the
+	// "substitution" strategy (the original single-character swap).
+	Dictionary map[rune][]string
+	// Keyboards lists the keyboard layouts to use for the "keyboard"
+	// strategy. Defaults to QWERTY and AZERTY when empty.
+	Keyboards []string
+	// TLDs is the curated list of TLDs/ccTLDs used by the "tld" strategy.
+	// Defaults to commonTLDs when empty.
+	TLDs []string
+	// BrandWords is the list of append words used by the "brand" strategy.
+	// Defaults to commonBrandWords when empty.
+	BrandWords []string
+}
+
+// Strategy generates candidates for label.tld. label must not contain a
+// dot; tld is everything after the registrable label.
+type Strategy func(label, tld string, opts Options) []Entry
+
+// registry maps a strategy name, as accepted by --strategies, to its
+// implementation.
+var registry = map[string]Strategy{
+	"substitution": Substitution,
+	"homoglyph":    Homoglyph,
+	"omission":     Omission,
+	"insertion":    Insertion,
+	"transposition": Transposition,
+	"duplication":  Duplication,
+	"keyboard":     Keyboard,
+	"bitsquat":     Bitsquat,
+	"tld":          TLDSwap,
+	"hyphenation":  Hyphenation,
+	"subdomain":    SubdomainInjection,
+	"brand":        BrandAppend,
+}
+
+// Names returns the sorted list of registered strategy names, mainly for
+// help text and tests.
+func Names() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Engine runs a fixed set of strategies over a label/TLD pair.
+type Engine struct {
+	strategies []Strategy
+	names      []string
+	opts       Options
+}
+
+// NewEngine builds an Engine from a list of strategy names, in the order
+// given by --strategies. An unknown name is a caller bug, so NewEngine
+// returns an error rather than silently dropping it.
+func NewEngine(names []string, opts Options) (*Engine, error) {
+	e := &Engine{opts: opts}
+	for _, name := range names {
+		strategy, found := registry[name]
+		if !found {
+			return nil, &UnknownStrategyError{Name: name}
+		}
+		e.strategies = append(e.strategies, strategy)
+		e.names = append(e.names, name)
+	}
+	return e, nil
+}
+
+// UnknownStrategyError is returned by NewEngine when a requested strategy
+// name isn't registered.
+type UnknownStrategyError struct {
+	Name string
+}
+
+func (e *UnknownStrategyError) Error() string {
+	return "permute: unknown strategy \"" + e.Name + "\""
+}
+
+// Generate runs every configured strategy against label.tld and returns the
+// concatenation of their results, preserving strategy order.
+func (e *Engine) Generate(label, tld string) []Entry {
+	entries := make([]Entry, 0)
+	for _, strategy := range e.strategies {
+		entries = append(entries, strategy(label, tld, e.opts)...)
+	}
+	return entries
+}
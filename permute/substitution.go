@@ -0,0 +1,22 @@
+package permute
+
+import "fmt"
+
+// Substitution is the original ditto strategy: replace a single character
+// of label with one of its dictionary lookalikes (e.g. "o" -> "0").
+func Substitution(label, tld string, opts Options) []Entry {
+	entries := make([]Entry, 0)
+	for i, c := range label {
+		subs, found := opts.Dictionary[c]
+		if !found {
+			continue
+		}
+		for _, sub := range subs {
+			entries = append(entries, Entry{
+				Domain:   fmt.Sprintf("%s%s%s.%s", label[:i], sub, label[i+1:], tld),
+				Strategy: "substitution",
+			})
+		}
+	}
+	return entries
+}
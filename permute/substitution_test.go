@@ -0,0 +1,15 @@
+package permute
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSubstitution(t *testing.T) {
+	dictionary := map[rune][]string{'o': {"0", "O"}}
+	got := domains(Substitution("google", "com", Options{Dictionary: dictionary}))
+	want := []string{"g0ogle.com", "gOogle.com", "go0gle.com", "goOgle.com"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Substitution(\"google\", \"com\") = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,34 @@
+package permute
+
+import "fmt"
+
+// commonTLDs is a curated shortlist of generic TLDs and ccTLDs frequently
+// used in typosquatting, covering the cases that matter far more often
+// than an exhaustive IANA list would: the popular gTLD alternatives and
+// the ccTLDs of countries with large internet populations.
+var commonTLDs = []string{
+	"com", "net", "org", "info", "biz", "co", "io",
+	"us", "uk", "de", "fr", "ru", "cn", "jp", "in",
+	"br", "au", "ca", "nl", "eu",
+}
+
+// TLDSwap re-parents label onto every TLD in opts.TLDs (default
+// commonTLDs), leaving the original tld out of the results.
+func TLDSwap(label, tld string, opts Options) []Entry {
+	tlds := opts.TLDs
+	if len(tlds) == 0 {
+		tlds = commonTLDs
+	}
+
+	entries := make([]Entry, 0)
+	for _, candidate := range tlds {
+		if candidate == tld {
+			continue
+		}
+		entries = append(entries, Entry{
+			Domain:   fmt.Sprintf("%s.%s", label, candidate),
+			Strategy: "tld",
+		})
+	}
+	return entries
+}
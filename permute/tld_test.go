@@ -0,0 +1,21 @@
+package permute
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTLDSwap(t *testing.T) {
+	got := domains(TLDSwap("example", "com", Options{TLDs: []string{"com", "net", "io"}}))
+	want := []string{"example.net", "example.io"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("TLDSwap(\"example\", \"com\") = %q, want %q", got, want)
+	}
+}
+
+func TestTLDSwapDefaultList(t *testing.T) {
+	got := TLDSwap("example", "zz", Options{})
+	if len(got) != len(commonTLDs) {
+		t.Fatalf("TLDSwap with default TLDs produced %d entries, want %d", len(got), len(commonTLDs))
+	}
+}
@@ -0,0 +1,55 @@
+// Package psl splits a domain into its registrable label and effective
+// TLD (eTLD) using the Public Suffix List, so permutation strategies only
+// ever mutate the part of a domain a registrant actually controls.
+//
+// This replaces the previous jpillora/go-tld based split, which only
+// understood a single trailing label as the "TLD" and so mis-split
+// multi-label suffixes like "co.uk" or "com.br" — mutating "co" or "com"
+// instead of leaving the eTLD alone.
+package psl
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
+)
+
+// Split returns domain's registrable label and its effective TLD, e.g.
+// Split("www.example.co.uk") returns ("example", "co.uk", nil). The
+// public suffix itself (the eTLD with no label in front of it, e.g. just
+// "co.uk") is rejected since there's nothing left to permute.
+func Split(domain string) (label, suffix string, err error) {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+
+	suffix, _ = publicsuffix.PublicSuffix(domain)
+	if suffix == domain {
+		// publicsuffix couldn't find a registrable label under the
+		// unicode form; try again against the ASCII/punycode form, since
+		// the generated PSL table keys IDN suffixes by their xn-- form.
+		if ascii, convErr := idna.ToASCII(domain); convErr == nil && ascii != domain {
+			if asciiSuffix, _ := publicsuffix.PublicSuffix(ascii); asciiSuffix != ascii {
+				return registrableLabel(strings.TrimSuffix(ascii, "."+asciiSuffix)), asciiSuffix, nil
+			}
+		}
+		return "", "", fmt.Errorf("psl: %q is itself a public suffix, nothing to permute", domain)
+	}
+
+	rest := strings.TrimSuffix(domain, "."+suffix)
+	if rest == "" {
+		return "", "", fmt.Errorf("psl: %q has no label in front of its suffix %q", domain, suffix)
+	}
+	return registrableLabel(rest), suffix, nil
+}
+
+// registrableLabel drops any subdomain labels in front of the eTLD+1
+// label, e.g. "www.example" -> "example", so callers only ever permute
+// the label a registrant actually controls rather than mutating "www"
+// (or the dot joining it to the label) as part of the candidate set.
+func registrableLabel(rest string) string {
+	if idx := strings.LastIndexByte(rest, '.'); idx >= 0 {
+		return rest[idx+1:]
+	}
+	return rest
+}
@@ -0,0 +1,46 @@
+package psl
+
+import "testing"
+
+func TestSplit(t *testing.T) {
+	cases := []struct {
+		domain     string
+		wantLabel  string
+		wantSuffix string
+	}{
+		{"example.co.uk", "example", "co.uk"},
+		{"example.com.br", "example", "com.br"},
+		{"example.com", "example", "com"},
+		{"www.example.co.uk", "example", "co.uk"},
+	}
+
+	for _, c := range cases {
+		label, suffix, err := Split(c.domain)
+		if err != nil {
+			t.Errorf("Split(%q) returned error: %v", c.domain, err)
+			continue
+		}
+		if label != c.wantLabel || suffix != c.wantSuffix {
+			t.Errorf("Split(%q) = (%q, %q), want (%q, %q)", c.domain, label, suffix, c.wantLabel, c.wantSuffix)
+		}
+	}
+}
+
+func TestSplitRejectsBareSuffix(t *testing.T) {
+	if _, _, err := Split("co.uk"); err == nil {
+		t.Fatal("Split(\"co.uk\") should error: a bare public suffix has nothing to permute")
+	}
+}
+
+func TestSplitIDNTLD(t *testing.T) {
+	// 例え.jp registers under the Japanese ccTLD; this should split to a
+	// non-empty label and a non-empty suffix however the PSL table keys
+	// the IDN variant (unicode or punycode).
+	label, suffix, err := Split("example.例え.jp")
+	if err != nil {
+		t.Fatalf("Split(\"example.例え.jp\") returned error: %v", err)
+	}
+	if label == "" || suffix == "" {
+		t.Fatalf("Split(\"example.例え.jp\") = (%q, %q), want non-empty label and suffix", label, suffix)
+	}
+}
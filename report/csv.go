@@ -0,0 +1,106 @@
+package report
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/krishpranav/ditto/whoisq"
+)
+
+// CSVReporter renders entries as rows in the original ditto CSV schema,
+// extended with the resolver-agreement, HTTP, and TLS columns added
+// alongside the matching probes.
+type CSVReporter struct {
+	writer *csv.Writer
+	opts   Options
+}
+
+// NewCSVReporter returns a CSVReporter writing to w and immediately emits
+// the header row.
+func NewCSVReporter(w io.Writer, opts Options) (*CSVReporter, error) {
+	r := &CSVReporter{writer: csv.NewWriter(w), opts: opts}
+
+	columns := []string{"unicode", "ascii", "status", "ips", "names", "resolvers_agree"}
+
+	if opts.WhoisInfo {
+		columns = append(columns, "registrar", "created_at", "updated_at", "expires_at", "nameservers")
+	}
+	if opts.HTTPProbe {
+		columns = append(columns, "http_status", "http_server", "http_title", "favicon_hash")
+	}
+	if opts.TLSProbe {
+		columns = append(columns, "tls_issuer", "tls_subject", "tls_sans", "tls_not_after", "tls_fingerprint")
+	}
+
+	if err := r.writer.Write(columns); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *CSVReporter) Report(entry *Entry) error {
+	if r.opts.Skip(entry) {
+		return nil
+	}
+
+	row := []string{entry.Domain, entry.Ascii, entry.Status.String()}
+
+	row = append(row, strings.Join(entry.Addresses, ","))
+	row = append(row, strings.Join(entry.Names, ","))
+
+	switch {
+	case entry.Status == whoisq.Available:
+		row = append(row, "n/a")
+	case disagrees(entry):
+		row = append(row, "no")
+	default:
+		row = append(row, "yes")
+	}
+
+	if r.opts.WhoisInfo {
+		if entry.Whois != nil {
+			if entry.Whois.Registrar != nil {
+				row = append(row, entry.Whois.Registrar.ReferralURL)
+			} else {
+				row = append(row, "")
+			}
+
+			if entry.Whois.Domain != nil {
+				row = append(row, entry.Whois.Domain.CreatedDate)
+				row = append(row, entry.Whois.Domain.UpdatedDate)
+				row = append(row, entry.Whois.Domain.ExpirationDate)
+				row = append(row, strings.Join(entry.Whois.Domain.NameServers, ","))
+			} else {
+				row = append(row, "", "", "", "")
+			}
+		} else {
+			row = append(row, "", "", "", "", "")
+		}
+	}
+
+	if r.opts.HTTPProbe {
+		if entry.HTTP != nil {
+			row = append(row, strconv.Itoa(entry.HTTP.Status), entry.HTTP.Server, entry.HTTP.Title, entry.HTTP.FaviconHash)
+		} else {
+			row = append(row, "", "", "", "")
+		}
+	}
+
+	if r.opts.TLSProbe {
+		if entry.TLS != nil {
+			row = append(row, entry.TLS.Issuer, entry.TLS.Subject, strings.Join(entry.TLS.SANs, ","), entry.TLS.NotAfter.Format(time.RFC3339), entry.TLS.Fingerprint)
+		} else {
+			row = append(row, "", "", "", "", "")
+		}
+	}
+
+	return r.writer.Write(row)
+}
+
+func (r *CSVReporter) Close() error {
+	r.writer.Flush()
+	return r.writer.Error()
+}
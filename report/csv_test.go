@@ -0,0 +1,41 @@
+package report
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/krishpranav/ditto/whoisq"
+)
+
+func TestCSVReporterHeaderAndRow(t *testing.T) {
+	var buf bytes.Buffer
+
+	r, err := NewCSVReporter(&buf, Options{})
+	if err != nil {
+		t.Fatalf("NewCSVReporter: %v", err)
+	}
+
+	if err := r.Report(&Entry{Domain: "example.com", Ascii: "example.com", Status: whoisq.Available}); err != nil {
+		t.Fatalf("Report: %v", err)
+	}
+	if err := r.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	records, err := csv.NewReader(strings.NewReader(buf.String())).ReadAll()
+	if err != nil {
+		t.Fatalf("reading back CSV: %v", err)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("got %d records, want 2 (header + row)", len(records))
+	}
+	if records[0][0] != "unicode" || records[0][2] != "status" {
+		t.Fatalf("unexpected header: %v", records[0])
+	}
+	if records[1][0] != "example.com" || records[1][2] != "available" {
+		t.Fatalf("unexpected row: %v", records[1])
+	}
+}
@@ -0,0 +1,25 @@
+// Package report turns a slice of candidate Entry results into output a
+// user or a downstream pipeline can consume, via a small Reporter
+// interface so new output formats don't require touching main().
+package report
+
+import (
+	"github.com/krishpranav/ditto/fingerprint"
+	"github.com/krishpranav/ditto/resolve"
+	"github.com/krishpranav/ditto/whoisq"
+	"github.com/likexian/whois-parser-go"
+)
+
+// Entry is a single candidate domain and everything ditto learned about
+// it. It's the shared schema every Reporter consumes.
+type Entry struct {
+	Domain    string                 `json:"domain"`
+	Ascii     string                 `json:"ascii"`
+	Status    whoisq.Status          `json:"status"`
+	Whois     *whoisparser.WhoisInfo `json:"whois,omitempty"`
+	Addresses []string               `json:"addresses,omitempty"`
+	Names     []string               `json:"names,omitempty"`
+	Records   resolve.Matrix         `json:"records,omitempty"`
+	HTTP      *fingerprint.HTTP      `json:"http,omitempty"`
+	TLS       *fingerprint.TLS       `json:"tls,omitempty"`
+}
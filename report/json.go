@@ -0,0 +1,35 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// JSONReporter buffers every non-skipped entry and writes them as a
+// single JSON array on Close, for callers who want one complete document
+// rather than a stream.
+type JSONReporter struct {
+	writer  io.Writer
+	opts    Options
+	entries []*Entry
+}
+
+// NewJSONReporter returns a JSONReporter writing a single JSON array to w
+// once Close is called.
+func NewJSONReporter(w io.Writer, opts Options) *JSONReporter {
+	return &JSONReporter{writer: w, opts: opts, entries: make([]*Entry, 0)}
+}
+
+func (r *JSONReporter) Report(entry *Entry) error {
+	if r.opts.Skip(entry) {
+		return nil
+	}
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func (r *JSONReporter) Close() error {
+	encoder := json.NewEncoder(r.writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r.entries)
+}
@@ -0,0 +1,40 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// JSONLReporter streams each entry as its own newline-delimited JSON
+// object as soon as it's reported, rather than waiting for every entry to
+// finish like JSONReporter does. This is the format to pick when feeding
+// a long-running downstream pipeline (jq, a log shipper, ...) that wants
+// to start consuming results before ditto is done.
+//
+// Report is safe to call concurrently, since ditto's async processing
+// queue completes entries out of order and may report more than one at
+// once.
+type JSONLReporter struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+	opts    Options
+}
+
+// NewJSONLReporter returns a JSONLReporter streaming to w.
+func NewJSONLReporter(w io.Writer, opts Options) *JSONLReporter {
+	return &JSONLReporter{encoder: json.NewEncoder(w), opts: opts}
+}
+
+func (r *JSONLReporter) Report(entry *Entry) error {
+	if r.opts.Skip(entry) {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.encoder.Encode(entry)
+}
+
+func (r *JSONLReporter) Close() error {
+	return nil
+}
@@ -0,0 +1,57 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/krishpranav/ditto/whoisq"
+)
+
+func TestJSONLReporterStreamsOneObjectPerLine(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewJSONLReporter(&buf, Options{})
+
+	if err := r.Report(&Entry{Domain: "a.com", Status: whoisq.Available}); err != nil {
+		t.Fatalf("Report: %v", err)
+	}
+	if err := r.Report(&Entry{Domain: "b.com", Status: whoisq.Registered}); err != nil {
+		t.Fatalf("Report: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2", len(lines))
+	}
+
+	var first Entry
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil {
+		t.Fatalf("unmarshal first line: %v", err)
+	}
+	if first.Domain != "a.com" {
+		t.Fatalf("first line domain = %q, want a.com", first.Domain)
+	}
+}
+
+func TestJSONReporterBuffersUntilClose(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewJSONReporter(&buf, Options{})
+
+	r.Report(&Entry{Domain: "a.com"})
+	if buf.Len() != 0 {
+		t.Fatal("JSONReporter must not write anything before Close")
+	}
+
+	if err := r.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	var entries []Entry
+	if err := json.Unmarshal(buf.Bytes(), &entries); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Domain != "a.com" {
+		t.Fatalf("got %v, want one entry for a.com", entries)
+	}
+}
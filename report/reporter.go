@@ -0,0 +1,72 @@
+package report
+
+import (
+	"github.com/krishpranav/ditto/resolve"
+	"github.com/krishpranav/ditto/whoisq"
+)
+
+// Options controls which entries a Reporter emits and how much detail it
+// includes. Every field mirrors a ditto CLI flag.
+type Options struct {
+	AvailableOnly  bool
+	RegisteredOnly bool
+	LiveOnly       bool
+	WhoisInfo      bool
+	HTTPProbe      bool
+	TLSProbe       bool
+	ResolverDiff   bool
+	SimilarFavicon string
+}
+
+// Reporter consumes Entry results. Report is called once per entry: ditto
+// streams to a JSONLReporter in the order entries finish processing, and
+// feeds every other Reporter after the scan, in generation order; Close is called once
+// after the last Report call to flush any buffered output (a CSV writer,
+// a JSON array's closing bracket, ...).
+type Reporter interface {
+	Report(entry *Entry) error
+	Close() error
+}
+
+// Skip reports whether entry should be omitted from output under opts,
+// regardless of which Reporter is rendering it. Keeping this logic in one
+// place means --available/--registered/--live/--resolver-diff/
+// --similar-favicon behave identically across every output format.
+func (opts Options) Skip(entry *Entry) bool {
+	isLive := len(entry.Addresses) > 0
+
+	switch entry.Status {
+	case whoisq.Available:
+		if opts.RegisteredOnly || opts.LiveOnly {
+			return true
+		}
+	case whoisq.Registered:
+		if opts.AvailableOnly {
+			return true
+		}
+		if opts.LiveOnly && !isLive {
+			return true
+		}
+	default: // whoisq.Unknown: not proven either way, so any status filter excludes it
+		if opts.AvailableOnly || opts.RegisteredOnly || opts.LiveOnly {
+			return true
+		}
+	}
+
+	if opts.ResolverDiff && !resolve.Disagrees(entry.Records) {
+		return true
+	}
+
+	if opts.SimilarFavicon != "" && (entry.HTTP == nil || entry.HTTP.FaviconHash != opts.SimilarFavicon) {
+		return true
+	}
+
+	return false
+}
+
+// disagrees reports whether entry's resolvers returned inconsistent
+// answers, a small wrapper so Reporter implementations don't each need to
+// import the resolve package directly.
+func disagrees(entry *Entry) bool {
+	return resolve.Disagrees(entry.Records)
+}
@@ -0,0 +1,48 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/krishpranav/ditto/fingerprint"
+	"github.com/krishpranav/ditto/whoisq"
+)
+
+func TestSkipAvailableOnly(t *testing.T) {
+	opts := Options{AvailableOnly: true}
+	if opts.Skip(&Entry{Status: whoisq.Available}) {
+		t.Fatal("AvailableOnly should not skip an available entry")
+	}
+	if !opts.Skip(&Entry{Status: whoisq.Registered}) {
+		t.Fatal("AvailableOnly should skip a registered entry")
+	}
+	if !opts.Skip(&Entry{Status: whoisq.Unknown}) {
+		t.Fatal("AvailableOnly should skip an entry whose status is unknown")
+	}
+}
+
+func TestSkipLiveOnly(t *testing.T) {
+	opts := Options{LiveOnly: true}
+	if !opts.Skip(&Entry{Status: whoisq.Registered, Addresses: nil}) {
+		t.Fatal("LiveOnly should skip a registered entry with no addresses")
+	}
+	if opts.Skip(&Entry{Status: whoisq.Registered, Addresses: []string{"1.2.3.4"}}) {
+		t.Fatal("LiveOnly should not skip a registered entry with addresses")
+	}
+	if !opts.Skip(&Entry{Status: whoisq.Available}) {
+		t.Fatal("LiveOnly should skip an available entry — it can't resolve to anything")
+	}
+}
+
+func TestSkipSimilarFavicon(t *testing.T) {
+	opts := Options{SimilarFavicon: "123"}
+
+	if !opts.Skip(&Entry{Status: whoisq.Registered}) {
+		t.Fatal("SimilarFavicon should skip an entry with no HTTP probe result")
+	}
+	if !opts.Skip(&Entry{Status: whoisq.Registered, HTTP: &fingerprint.HTTP{FaviconHash: "456"}}) {
+		t.Fatal("SimilarFavicon should skip an entry with a different favicon hash")
+	}
+	if opts.Skip(&Entry{Status: whoisq.Registered, HTTP: &fingerprint.HTTP{FaviconHash: "123"}}) {
+		t.Fatal("SimilarFavicon should not skip an entry with a matching favicon hash")
+	}
+}
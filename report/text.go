@@ -0,0 +1,102 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/evilsocket/islazy/tui"
+	"github.com/krishpranav/ditto/whoisq"
+)
+
+// TextReporter renders entries as the original ditto human-readable
+// output: one coloured line per entry, with whois details indented below
+// when requested.
+type TextReporter struct {
+	Writer io.Writer
+	Opts   Options
+}
+
+// NewTextReporter returns a TextReporter writing to w.
+func NewTextReporter(w io.Writer, opts Options) *TextReporter {
+	return &TextReporter{Writer: w, Opts: opts}
+}
+
+func (r *TextReporter) Report(entry *Entry) error {
+	if r.Opts.Skip(entry) {
+		return nil
+	}
+
+	if entry.Status == whoisq.Available {
+		fmt.Fprintf(r.Writer, "%s (%s) : %s\n", entry.Domain, entry.Ascii, tui.Green("available"))
+		return nil
+	}
+
+	mainFields := []string{}
+	whoisFields := []string{}
+	isLive := len(entry.Addresses) > 0
+
+	if isLive {
+		mainFields = append(mainFields, fmt.Sprintf("ips=%s", strings.Join(entry.Addresses, ",")))
+		if len(entry.Names) > 0 {
+			mainFields = append(mainFields, fmt.Sprintf("names=%s", strings.Join(entry.Names, ",")))
+		}
+		if disagrees(entry) {
+			mainFields = append(mainFields, "resolvers=disagree")
+		}
+		if entry.HTTP != nil {
+			mainFields = append(mainFields, fmt.Sprintf("http_status=%d", entry.HTTP.Status))
+			if entry.HTTP.Server != "" {
+				mainFields = append(mainFields, fmt.Sprintf("http_server=%s", entry.HTTP.Server))
+			}
+			if entry.HTTP.Title != "" {
+				mainFields = append(mainFields, fmt.Sprintf("http_title=%s", entry.HTTP.Title))
+			}
+			if entry.HTTP.FaviconHash != "" {
+				mainFields = append(mainFields, fmt.Sprintf("favicon_hash=%s", entry.HTTP.FaviconHash))
+			}
+		}
+		if entry.TLS != nil {
+			mainFields = append(mainFields, fmt.Sprintf("tls_subject=%s", entry.TLS.Subject))
+			mainFields = append(mainFields, fmt.Sprintf("tls_fingerprint=%s", entry.TLS.Fingerprint))
+		}
+	}
+
+	if entry.Whois != nil {
+		if entry.Whois.Registrar != nil {
+			whoisFields = append(whoisFields, fmt.Sprintf("registrar=%s", entry.Whois.Registrar.ReferralURL))
+		}
+
+		if entry.Whois.Domain != nil {
+			whoisFields = append(whoisFields, fmt.Sprintf("created=%s", entry.Whois.Domain.CreatedDate))
+			whoisFields = append(whoisFields, fmt.Sprintf("updated=%s", entry.Whois.Domain.UpdatedDate))
+			whoisFields = append(whoisFields, fmt.Sprintf("expires=%s", entry.Whois.Domain.ExpirationDate))
+			whoisFields = append(whoisFields, fmt.Sprintf("ns=%s", strings.Join(entry.Whois.Domain.NameServers, ",")))
+		}
+	}
+
+	label := tui.Red("registered")
+	if entry.Status == whoisq.Unknown {
+		label = tui.Yellow("unknown")
+	}
+
+	fmt.Fprintf(r.Writer, "%s (%s) %s", entry.Domain, entry.Ascii, label)
+
+	if len(mainFields) > 0 {
+		fmt.Fprintf(r.Writer, " : %s", strings.Join(mainFields, " "))
+	}
+
+	fmt.Fprintln(r.Writer)
+
+	if r.Opts.WhoisInfo && len(whoisFields) > 0 {
+		for _, field := range whoisFields {
+			fmt.Fprintf(r.Writer, "  %s\n", field)
+		}
+	}
+
+	return nil
+}
+
+func (r *TextReporter) Close() error {
+	return nil
+}
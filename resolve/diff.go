@@ -0,0 +1,46 @@
+package resolve
+
+import "sort"
+
+// Disagrees reports whether any record type in matrix has a different
+// answer set depending on which resolver was asked. An empty answer set is
+// ignored for each record type on its own (a nameserver that timed out,
+// or that returned nothing for just that type), since "no answer" is not
+// itself a disagreement worth flagging.
+func Disagrees(matrix Matrix) bool {
+	seen := make(map[string]string, len(RecordTypes))
+
+	for _, records := range matrix {
+		for recordType, answers := range records {
+			if len(answers) == 0 {
+				continue
+			}
+			key := normalize(answers)
+			if prev, found := seen[recordType]; found {
+				if prev != key {
+					return true
+				}
+			} else {
+				seen[recordType] = key
+			}
+		}
+	}
+	return false
+}
+
+// normalize produces an order-independent signature for an answer set so
+// two resolvers that returned the same records in a different order don't
+// register as a disagreement.
+func normalize(answers []string) string {
+	sorted := append([]string{}, answers...)
+	sort.Strings(sorted)
+
+	key := ""
+	for i, a := range sorted {
+		if i > 0 {
+			key += ","
+		}
+		key += a
+	}
+	return key
+}
@@ -0,0 +1,43 @@
+package resolve
+
+import "testing"
+
+func TestDisagreesFalseWhenMatching(t *testing.T) {
+	matrix := Matrix{
+		"8.8.8.8:53": {"A": {"1.2.3.4"}},
+		"1.1.1.1:53": {"A": {"1.2.3.4"}},
+	}
+	if Disagrees(matrix) {
+		t.Fatal("Disagrees() = true for identical answers, want false")
+	}
+}
+
+func TestDisagreesFalseWhenOrderDiffers(t *testing.T) {
+	matrix := Matrix{
+		"8.8.8.8:53": {"A": {"1.2.3.4", "5.6.7.8"}},
+		"1.1.1.1:53": {"A": {"5.6.7.8", "1.2.3.4"}},
+	}
+	if Disagrees(matrix) {
+		t.Fatal("Disagrees() = true for reordered answers, want false")
+	}
+}
+
+func TestDisagreesTrueOnMismatch(t *testing.T) {
+	matrix := Matrix{
+		"8.8.8.8:53": {"A": {"1.2.3.4"}},
+		"1.1.1.1:53": {"A": {"9.9.9.9"}},
+	}
+	if !Disagrees(matrix) {
+		t.Fatal("Disagrees() = false for mismatched answers, want true")
+	}
+}
+
+func TestDisagreesIgnoresEmptyAnswers(t *testing.T) {
+	matrix := Matrix{
+		"8.8.8.8:53": {"A": {"1.2.3.4"}},
+		"1.1.1.1:53": {"A": {}},
+	}
+	if Disagrees(matrix) {
+		t.Fatal("Disagrees() = true when one resolver simply returned nothing, want false")
+	}
+}
@@ -0,0 +1,136 @@
+// Package resolve queries a candidate domain against several DNS resolvers
+// in parallel so callers can spot inconsistencies between them — a squat
+// that resolves differently depending on which resolver you ask is a
+// tell-tale sign of a split-horizon phishing setup or a hijack in
+// progress.
+package resolve
+
+import (
+	"sync"
+
+	"github.com/miekg/dns"
+)
+
+// DefaultNameservers is the set of public resolvers ditto always queries;
+// --nameservers adds to it rather than replacing it. New also falls back
+// to it when given no nameservers.
+var DefaultNameservers = []string{
+	"8.8.8.8:53",        // Google
+	"1.1.1.1:53",        // Cloudflare
+	"9.9.9.9:53",        // Quad9
+	"208.67.222.222:53", // OpenDNS
+}
+
+// RecordTypes is the set of record types queried for every candidate.
+var RecordTypes = map[string]uint16{
+	"A":     dns.TypeA,
+	"AAAA":  dns.TypeAAAA,
+	"MX":    dns.TypeMX,
+	"NS":    dns.TypeNS,
+	"TXT":   dns.TypeTXT,
+	"CNAME": dns.TypeCNAME,
+}
+
+// Matrix is a resolver address -> record type -> answer strings.
+type Matrix map[string]map[string][]string
+
+// Resolver queries a fixed list of nameservers.
+type Resolver struct {
+	Nameservers []string
+	client      *dns.Client
+}
+
+// New builds a Resolver over nameservers, falling back to
+// DefaultNameservers when none are given.
+func New(nameservers []string) *Resolver {
+	if len(nameservers) == 0 {
+		nameservers = DefaultNameservers
+	}
+	return &Resolver{
+		Nameservers: nameservers,
+		client:      new(dns.Client),
+	}
+}
+
+// Query resolves a single record type against a single nameserver.
+func (r *Resolver) Query(nameserver, domain string, qtype uint16) []string {
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(domain), qtype)
+	msg.RecursionDesired = true
+
+	resp, _, err := r.client.Exchange(msg, nameserver)
+	if err != nil || resp == nil {
+		return nil
+	}
+
+	answers := make([]string, 0, len(resp.Answer))
+	for _, rr := range resp.Answer {
+		answers = append(answers, recordValue(rr))
+	}
+	return answers
+}
+
+// Resolve queries every record type in RecordTypes against every one of
+// r.Nameservers, in parallel, and returns the full resolver-by-record
+// matrix for domain.
+func (r *Resolver) Resolve(domain string) Matrix {
+	matrix := make(Matrix, len(r.Nameservers))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, ns := range r.Nameservers {
+		ns := ns
+		records := make(map[string][]string, len(RecordTypes))
+
+		mu.Lock()
+		matrix[ns] = records
+		mu.Unlock()
+
+		for name, qtype := range RecordTypes {
+			wg.Add(1)
+			go func(name string, qtype uint16) {
+				defer wg.Done()
+				answers := r.Query(ns, domain, qtype)
+				mu.Lock()
+				records[name] = answers
+				mu.Unlock()
+			}(name, qtype)
+		}
+	}
+
+	wg.Wait()
+	return matrix
+}
+
+// recordValue extracts the human-readable payload of an answer RR,
+// trimming it down to just the value callers care about (the IP, the
+// mail exchanger host, the text, etc.) rather than the full RR string.
+func recordValue(rr dns.RR) string {
+	switch v := rr.(type) {
+	case *dns.A:
+		return v.A.String()
+	case *dns.AAAA:
+		return v.AAAA.String()
+	case *dns.MX:
+		return v.Mx
+	case *dns.NS:
+		return v.Ns
+	case *dns.TXT:
+		return joinTXT(v.Txt)
+	case *dns.CNAME:
+		return v.Target
+	default:
+		return rr.String()
+	}
+}
+
+func joinTXT(chunks []string) string {
+	joined := ""
+	for i, chunk := range chunks {
+		if i > 0 {
+			joined += " "
+		}
+		joined += chunk
+	}
+	return joined
+}
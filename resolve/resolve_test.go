@@ -0,0 +1,82 @@
+package resolve
+
+import (
+	"net"
+	"testing"
+
+	"github.com/miekg/dns"
+)
+
+func TestRecordValueA(t *testing.T) {
+	rr := &dns.A{A: net.ParseIP("1.2.3.4")}
+	if got := recordValue(rr); got != "1.2.3.4" {
+		t.Fatalf("recordValue(A) = %q, want %q", got, "1.2.3.4")
+	}
+}
+
+func TestRecordValueAAAA(t *testing.T) {
+	rr := &dns.AAAA{AAAA: net.ParseIP("::1")}
+	if got := recordValue(rr); got != "::1" {
+		t.Fatalf("recordValue(AAAA) = %q, want %q", got, "::1")
+	}
+}
+
+func TestRecordValueMX(t *testing.T) {
+	rr := &dns.MX{Mx: "mail.example.com."}
+	if got := recordValue(rr); got != "mail.example.com." {
+		t.Fatalf("recordValue(MX) = %q, want %q", got, "mail.example.com.")
+	}
+}
+
+func TestRecordValueNS(t *testing.T) {
+	rr := &dns.NS{Ns: "ns1.example.com."}
+	if got := recordValue(rr); got != "ns1.example.com." {
+		t.Fatalf("recordValue(NS) = %q, want %q", got, "ns1.example.com.")
+	}
+}
+
+func TestRecordValueCNAME(t *testing.T) {
+	rr := &dns.CNAME{Target: "target.example.com."}
+	if got := recordValue(rr); got != "target.example.com." {
+		t.Fatalf("recordValue(CNAME) = %q, want %q", got, "target.example.com.")
+	}
+}
+
+func TestRecordValueTXT(t *testing.T) {
+	rr := &dns.TXT{Txt: []string{"v=spf1", "-all"}}
+	if got := recordValue(rr); got != "v=spf1 -all" {
+		t.Fatalf("recordValue(TXT) = %q, want %q", got, "v=spf1 -all")
+	}
+}
+
+func TestJoinTXTEmpty(t *testing.T) {
+	if got := joinTXT(nil); got != "" {
+		t.Fatalf("joinTXT(nil) = %q, want empty string", got)
+	}
+}
+
+func TestJoinTXTSingleChunk(t *testing.T) {
+	if got := joinTXT([]string{"v=spf1"}); got != "v=spf1" {
+		t.Fatalf("joinTXT = %q, want %q", got, "v=spf1")
+	}
+}
+
+func TestJoinTXTMultipleChunks(t *testing.T) {
+	if got := joinTXT([]string{"v=spf1", "include:example.com", "-all"}); got != "v=spf1 include:example.com -all" {
+		t.Fatalf("joinTXT = %q, want %q", got, "v=spf1 include:example.com -all")
+	}
+}
+
+func TestNewFallsBackToDefaultNameservers(t *testing.T) {
+	r := New(nil)
+	if len(r.Nameservers) != len(DefaultNameservers) {
+		t.Fatalf("New(nil).Nameservers = %v, want DefaultNameservers", r.Nameservers)
+	}
+}
+
+func TestNewUsesGivenNameservers(t *testing.T) {
+	r := New([]string{"127.0.0.1:53"})
+	if len(r.Nameservers) != 1 || r.Nameservers[0] != "127.0.0.1:53" {
+		t.Fatalf("New([127.0.0.1:53]).Nameservers = %v, want [127.0.0.1:53]", r.Nameservers)
+	}
+}
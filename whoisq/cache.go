@@ -0,0 +1,95 @@
+package whoisq
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	whoisparser "github.com/likexian/whois-parser-go"
+	bolt "go.etcd.io/bbolt"
+)
+
+var cacheBucket = []byte("whoisq")
+
+// defaultTTL is how long a cached lookup is trusted before whoisq will
+// re-query the registry for it.
+const defaultTTL = 24 * time.Hour
+
+// Cache persists recent lookups to disk so re-running ditto against the
+// same candidate list (e.g. under --watch) doesn't re-query WHOIS/RDAP
+// for entries that haven't aged out yet.
+type Cache struct {
+	db  *bolt.DB
+	ttl time.Duration
+}
+
+type cacheEntry struct {
+	Status    Status                 `json:"status"`
+	Whois     *whoisparser.WhoisInfo `json:"whois,omitempty"`
+	FetchedAt time.Time              `json:"fetched_at"`
+}
+
+// OpenCache opens (creating if necessary) a BoltDB-backed cache at path,
+// expiring entries older than ttl. A ttl <= 0 uses the 24h default.
+func OpenCache(path string, ttl time.Duration) (*Cache, error) {
+	if ttl <= 0 {
+		ttl = defaultTTL
+	}
+
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("whoisq: opening cache: %w", err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(cacheBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("whoisq: initializing cache: %w", err)
+	}
+
+	return &Cache{db: db, ttl: ttl}, nil
+}
+
+// get returns the cached status/whois info for domain, if present and
+// not yet expired.
+func (c *Cache) get(domain string) (Status, *whoisparser.WhoisInfo, bool) {
+	var entry cacheEntry
+	found := false
+
+	c.db.View(func(tx *bolt.Tx) error {
+		raw := tx.Bucket(cacheBucket).Get([]byte(domain))
+		if raw == nil {
+			return nil
+		}
+		if err := json.Unmarshal(raw, &entry); err != nil {
+			return nil
+		}
+		found = true
+		return nil
+	})
+
+	if !found || time.Since(entry.FetchedAt) > c.ttl {
+		return Unknown, nil, false
+	}
+	return entry.Status, entry.Whois, true
+}
+
+// set persists the outcome of a fresh lookup for domain.
+func (c *Cache) set(domain string, status Status, info *whoisparser.WhoisInfo) error {
+	raw, err := json.Marshal(cacheEntry{Status: status, Whois: info, FetchedAt: time.Now()})
+	if err != nil {
+		return fmt.Errorf("whoisq: encoding cache entry: %w", err)
+	}
+
+	return c.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(cacheBucket).Put([]byte(domain), raw)
+	})
+}
+
+// Close releases the cache's database file.
+func (c *Cache) Close() error {
+	return c.db.Close()
+}
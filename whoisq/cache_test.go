@@ -0,0 +1,53 @@
+package whoisq
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	whoisparser "github.com/likexian/whois-parser-go"
+)
+
+func TestCacheRoundTrip(t *testing.T) {
+	cache, err := OpenCache(filepath.Join(t.TempDir(), "whoisq.db"), time.Hour)
+	if err != nil {
+		t.Fatalf("OpenCache: %v", err)
+	}
+	defer cache.Close()
+
+	info := &whoisparser.WhoisInfo{Domain: &whoisparser.Domain{CreatedDate: "2020-01-01"}}
+	if err := cache.set("squat.com", Registered, info); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+
+	status, got, ok := cache.get("squat.com")
+	if !ok || status != Registered || got.Domain.CreatedDate != "2020-01-01" {
+		t.Fatalf("get = (%v, %v, %v), want (Registered, created 2020-01-01, true)", status, got, ok)
+	}
+}
+
+func TestCacheMiss(t *testing.T) {
+	cache, err := OpenCache(filepath.Join(t.TempDir(), "whoisq.db"), time.Hour)
+	if err != nil {
+		t.Fatalf("OpenCache: %v", err)
+	}
+	defer cache.Close()
+
+	if _, _, ok := cache.get("never-seen.com"); ok {
+		t.Fatal("get should miss for a domain never cached")
+	}
+}
+
+func TestCacheExpiry(t *testing.T) {
+	cache, err := OpenCache(filepath.Join(t.TempDir(), "whoisq.db"), time.Hour)
+	if err != nil {
+		t.Fatalf("OpenCache: %v", err)
+	}
+	defer cache.Close()
+	cache.ttl = -time.Second // force every entry to already be expired
+
+	cache.set("squat.com", Registered, nil)
+	if _, _, ok := cache.get("squat.com"); ok {
+		t.Fatal("get should miss once the entry has expired")
+	}
+}
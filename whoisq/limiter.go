@@ -0,0 +1,34 @@
+package whoisq
+
+import (
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// limiters hands out one token-bucket rate limiter per WHOIS host, so a
+// burst of candidates sharing a slow or strict registry don't all hit it
+// at once while candidates against other registries keep flowing freely.
+type limiters struct {
+	mu      sync.Mutex
+	perHost map[string]*rate.Limiter
+	rps     rate.Limit
+	burst   int
+}
+
+func newLimiters(rps float64, burst int) *limiters {
+	return &limiters{perHost: make(map[string]*rate.Limiter), rps: rate.Limit(rps), burst: burst}
+}
+
+// get returns the limiter for host, creating one on first use.
+func (l *limiters) get(host string) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	lim, ok := l.perHost[host]
+	if !ok {
+		lim = rate.NewLimiter(l.rps, l.burst)
+		l.perHost[host] = lim
+	}
+	return lim
+}
@@ -0,0 +1,18 @@
+package whoisq
+
+import "testing"
+
+func TestLimitersGetReusesPerHost(t *testing.T) {
+	l := newLimiters(DefaultRate, DefaultBurst)
+
+	a := l.get("whois.verisign-grs.com")
+	b := l.get("whois.verisign-grs.com")
+	if a != b {
+		t.Fatal("get should return the same limiter for the same host")
+	}
+
+	c := l.get("whois.nic.io")
+	if a == c {
+		t.Fatal("get should return distinct limiters for distinct hosts")
+	}
+}
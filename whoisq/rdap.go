@@ -0,0 +1,76 @@
+package whoisq
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	whoisparser "github.com/likexian/whois-parser-go"
+)
+
+// rdapBootstrap is IANA's generic RDAP bootstrap redirector: it resolves
+// to the authoritative RDAP server for whatever TLD the domain belongs
+// to, so whoisq doesn't need to maintain its own RDAP server list.
+const rdapBootstrap = "https://rdap.org/domain/"
+
+// rdapResponse captures the handful of RDAP fields whoisq cares about.
+// RDAP responses carry far more (vCard entities, notices, links, ...)
+// than this, but this is enough to fill in the same Domain fields the
+// WHOIS path already reports.
+type rdapResponse struct {
+	Nameservers []struct {
+		LDHName string `json:"ldhName"`
+	} `json:"nameservers"`
+	Events []struct {
+		Action string `json:"eventAction"`
+		Date   string `json:"eventDate"`
+	} `json:"events"`
+}
+
+// rdapLookup queries the RDAP bootstrap service for domain, used as a
+// fallback when the legacy WHOIS protocol doesn't give a clear answer —
+// a timeout, a registry with no WHOIS server, or a response whoisparser
+// can't make sense of.
+func rdapLookup(domain string) (Status, *whoisparser.WhoisInfo, error) {
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	resp, err := client.Get(rdapBootstrap + domain)
+	if err != nil {
+		return Unknown, nil, fmt.Errorf("whoisq: rdap request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return Available, nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return Unknown, nil, fmt.Errorf("whoisq: rdap returned %s", resp.Status)
+	}
+
+	var parsed rdapResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return Unknown, nil, fmt.Errorf("whoisq: decoding rdap response: %w", err)
+	}
+
+	info := &whoisparser.WhoisInfo{
+		Domain: &whoisparser.Domain{
+			NameServers: make([]string, 0, len(parsed.Nameservers)),
+		},
+	}
+	for _, ns := range parsed.Nameservers {
+		info.Domain.NameServers = append(info.Domain.NameServers, ns.LDHName)
+	}
+	for _, event := range parsed.Events {
+		switch event.Action {
+		case "registration":
+			info.Domain.CreatedDate = event.Date
+		case "last changed":
+			info.Domain.UpdatedDate = event.Date
+		case "expiration":
+			info.Domain.ExpirationDate = event.Date
+		}
+	}
+
+	return Registered, info, nil
+}
@@ -0,0 +1,26 @@
+package whoisq
+
+import "github.com/krishpranav/ditto/psl"
+
+// servers overrides the WHOIS host ditto queries for TLDs whose registry
+// doesn't answer (or answers unreliably) via the default IANA referral
+// chain that github.com/domainr/whois follows.
+var servers = map[string]string{
+	"ai":  "whois.nic.ai",
+	"io":  "whois.nic.io",
+	"co":  "whois.nic.co",
+	"dev": "whois.nic.google",
+	"app": "whois.nic.google",
+	"me":  "whois.nic.me",
+	"xyz": "whois.nic.xyz",
+}
+
+// serverFor returns the WHOIS host to query for domain's suffix, or ""
+// to fall back to the default referral chain.
+func serverFor(domain string) string {
+	_, suffix, err := psl.Split(domain)
+	if err != nil {
+		return ""
+	}
+	return servers[suffix]
+}
@@ -0,0 +1,21 @@
+package whoisq
+
+import "testing"
+
+func TestServerForOverride(t *testing.T) {
+	if got := serverFor("squat.io"); got != "whois.nic.io" {
+		t.Fatalf("serverFor(squat.io) = %q, want whois.nic.io", got)
+	}
+}
+
+func TestServerForNoOverride(t *testing.T) {
+	if got := serverFor("squat.com"); got != "" {
+		t.Fatalf("serverFor(squat.com) = %q, want \"\"", got)
+	}
+}
+
+func TestServerForInvalidDomain(t *testing.T) {
+	if got := serverFor("com"); got != "" {
+		t.Fatalf("serverFor(com) = %q, want \"\"", got)
+	}
+}
@@ -0,0 +1,50 @@
+package whoisq
+
+import "encoding/json"
+
+// Status is the three-state outcome of a domain lookup. ditto used to
+// treat any WHOIS error — a timeout, a rate limit, a registry with no
+// WHOIS server at all — as proof a domain was available, which produced
+// false positives. Unknown lets callers tell "the registry said no
+// match" apart from "we couldn't get a straight answer".
+type Status int
+
+const (
+	Unknown Status = iota
+	Available
+	Registered
+)
+
+func (s Status) String() string {
+	switch s {
+	case Available:
+		return "available"
+	case Registered:
+		return "registered"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalJSON renders Status as its string form so CSV/JSON/cache output
+// reads "available"/"registered"/"unknown" instead of a bare int.
+func (s Status) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+func (s *Status) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	switch str {
+	case "available":
+		*s = Available
+	case "registered":
+		*s = Registered
+	default:
+		*s = Unknown
+	}
+	return nil
+}
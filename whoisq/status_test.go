@@ -0,0 +1,48 @@
+package whoisq
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStatusString(t *testing.T) {
+	cases := map[Status]string{
+		Available:  "available",
+		Registered: "registered",
+		Unknown:    "unknown",
+		Status(99): "unknown",
+	}
+
+	for status, want := range cases {
+		if got := status.String(); got != want {
+			t.Fatalf("Status(%d).String() = %q, want %q", status, got, want)
+		}
+	}
+}
+
+func TestStatusJSONRoundTrip(t *testing.T) {
+	for _, status := range []Status{Available, Registered, Unknown} {
+		raw, err := json.Marshal(status)
+		if err != nil {
+			t.Fatalf("Marshal(%v): %v", status, err)
+		}
+
+		var got Status
+		if err := json.Unmarshal(raw, &got); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", raw, err)
+		}
+		if got != status {
+			t.Fatalf("round-tripped %v as %v", status, got)
+		}
+	}
+}
+
+func TestStatusUnmarshalUnknownString(t *testing.T) {
+	var got Status
+	if err := json.Unmarshal([]byte(`"pending"`), &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if got != Unknown {
+		t.Fatalf("got %v, want Unknown", got)
+	}
+}
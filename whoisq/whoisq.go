@@ -0,0 +1,117 @@
+// Package whoisq performs rate-limited, backend-diverse WHOIS lookups
+// with an automatic RDAP fallback and an on-disk TTL cache, and reports
+// an explicit Available/Registered/Unknown Status rather than collapsing
+// every failure mode into "available" the way ditto's original lookup
+// did.
+package whoisq
+
+import (
+	"context"
+	"strings"
+
+	"github.com/domainr/whois"
+	whoisparser "github.com/likexian/whois-parser-go"
+)
+
+// DefaultRate and DefaultBurst are the per-host token-bucket parameters
+// used when a caller doesn't need anything more conservative.
+const (
+	DefaultRate  = 1.0
+	DefaultBurst = 2
+)
+
+// notFoundPhrases are substrings WHOIS servers commonly use to say "no
+// such registration" in an otherwise unparseable response. whoisparser
+// only understands registries it has a template for, so without this
+// check a "no match" response from an unsupported registry would be
+// indistinguishable from a network failure.
+var notFoundPhrases = []string{
+	"no match",
+	"not found",
+	"no entries found",
+	"no data found",
+	"status: free",
+	"domain not found",
+	"no object found",
+	"object does not exist",
+}
+
+// Client looks up domain registration status. It is safe for concurrent
+// use.
+type Client struct {
+	limiters *limiters
+	cache    *Cache
+}
+
+// NewClient returns a Client that rate-limits each WHOIS host to rps
+// queries/sec (allowing burst requests in a single instant) and caches
+// results in cache. cache may be nil to disable caching.
+func NewClient(cache *Cache, rps float64, burst int) *Client {
+	return &Client{limiters: newLimiters(rps, burst), cache: cache}
+}
+
+// Lookup resolves domain's registration status, preferring a cached
+// result, then the legacy WHOIS protocol (rate-limited per host, honoring
+// the per-TLD server overrides), and falling back to RDAP whenever WHOIS
+// doesn't give a clear answer.
+func (c *Client) Lookup(domain string) (Status, *whoisparser.WhoisInfo) {
+	if c.cache != nil {
+		if status, info, ok := c.cache.get(domain); ok {
+			return status, info
+		}
+	}
+
+	status, info := c.lookup(domain)
+
+	// Unknown means WHOIS and RDAP both failed to give a straight answer
+	// (a timeout, a rate limit, a registry with no server at all) — not a
+	// confirmed state, so it isn't worth freezing for a full TTL. Leaving
+	// it uncached means the next lookup (the next --watch tick, or simply
+	// rerunning ditto) retries instead of parroting the same non-answer.
+	if c.cache != nil && status != Unknown {
+		c.cache.set(domain, status, info)
+	}
+
+	return status, info
+}
+
+func (c *Client) lookup(domain string) (Status, *whoisparser.WhoisInfo) {
+	host := serverFor(domain)
+
+	req, err := whois.NewRequest(domain)
+	if err == nil {
+		if host != "" {
+			req.Host = host
+		}
+		c.limiters.get(req.Host).Wait(context.Background())
+
+		if resp, err := whois.DefaultClient.Fetch(req); err == nil {
+			body := string(resp.Body)
+
+			if looksAvailable(body) {
+				return Available, nil
+			}
+			if parsed, err := whoisparser.Parse(body); err == nil {
+				return Registered, &parsed
+			}
+		}
+	}
+
+	if status, info, err := rdapLookup(domain); err == nil {
+		return status, info
+	}
+
+	return Unknown, nil
+}
+
+// looksAvailable reports whether body reads like a WHOIS "no match"
+// response, for registries whoisparser has no template for.
+func looksAvailable(body string) bool {
+	lower := strings.ToLower(body)
+	for _, phrase := range notFoundPhrases {
+		if strings.Contains(lower, phrase) {
+			return true
+		}
+	}
+	return false
+}
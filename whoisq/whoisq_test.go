@@ -0,0 +1,18 @@
+package whoisq
+
+import "testing"
+
+func TestLooksAvailable(t *testing.T) {
+	cases := map[string]bool{
+		"No match for domain \"SQUAT.COM\"":      true,
+		"Domain not found":                       true,
+		"NOT FOUND\n>>> Last update: 2024-01-01": true,
+		"Domain Name: SQUAT.COM\nRegistrar: Foo": false,
+	}
+
+	for body, want := range cases {
+		if got := looksAvailable(body); got != want {
+			t.Fatalf("looksAvailable(%q) = %v, want %v", body, got, want)
+		}
+	}
+}